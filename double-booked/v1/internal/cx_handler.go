@@ -10,24 +10,109 @@ import (
 	"github.com/aws/aws-lambda-go/events"
 )
 
+// List of resources served by this lambda function, any other resource finds the double booked events
+const (
+	resourceConcurrency  = "/v1/concurrency"
+	resourceFreeBusy     = "/v1/free-busy"
+	resourceMeetingSlots = "/v1/meeting-slots"
+	resourceCanBook      = "/v1/can-book"
+	resourceDiff         = "/v1/diff"
+	resourceReschedule   = "/v1/reschedule"
+	resourceTracks       = "/v1/tracks"
+)
+
 // Handler declaration of handler struct used in this file
 type Handler struct {
 	findDoubleBookedEventsUC FindDoubleBookedEventsUCInterface
 	parseEventsToUTCUC       ParseEventsToUTCUCInterface
+	findPeakConcurrencyUC    FindPeakConcurrencyUCInterface
+	findFreeBusyUC           FindFreeBusyUCInterface
+	findMeetingSlotsUC       FindMeetingSlotsUCInterface
+	checkAvailabilityUC      CheckAvailabilityUCInterface
+	diffCalendarsUC          DiffCalendarsUCInterface
+	proposeReschedulesUC     ProposeReschedulesUCInterface
+	assignTracksUC           AssignTracksUCInterface
 }
 
 // FindDoubleBookedEventsUCInterface interface for this use case
 type FindDoubleBookedEventsUCInterface interface {
-	Handle(events models.Events) (models.DoubleBookedEvents, error)
+	Handle(events models.Events, options models.Options) (models.DoubleBookedReport, error)
 }
 
 // ParseEventsToUTCUCInterface interface for this use case
 type ParseEventsToUTCUCInterface interface {
-	Handle(events models.Events) (models.Events, error)
+	Handle(events models.Events, options models.Options) (models.Events, models.Warnings, error)
+}
+
+// FindPeakConcurrencyUCInterface interface for this use case
+type FindPeakConcurrencyUCInterface interface {
+	Handle(events models.Events, options models.Options) (models.ConcurrencyReport, error)
+}
+
+// FindFreeBusyUCInterface interface for this use case
+type FindFreeBusyUCInterface interface {
+	Handle(events models.Events, window models.Event, options models.Options) (models.FreeBusyReport, error)
+}
+
+// FindMeetingSlotsUCInterface interface for this use case
+type FindMeetingSlotsUCInterface interface {
+	Handle(
+		participants models.Participants,
+		window models.Event,
+		slotRequest models.SlotRequest,
+		options models.Options,
+	) (models.MeetingSlotsReport, error)
+}
+
+// CheckAvailabilityUCInterface interface for this use case
+type CheckAvailabilityUCInterface interface {
+	Handle(events models.Events, candidate models.Events, options models.Options) (models.AvailabilityReport, error)
+}
+
+// DiffCalendarsUCInterface interface for this use case
+type DiffCalendarsUCInterface interface {
+	Handle(before models.Events, after models.Events, options models.Options) (models.CalendarDiffReport, error)
+}
+
+// ProposeReschedulesUCInterface interface for this use case
+type ProposeReschedulesUCInterface interface {
+	Handle(
+		events models.Events,
+		window models.Event,
+		request models.RescheduleRequest,
+		options models.Options,
+	) (models.RescheduleReport, error)
+}
+
+// AssignTracksUCInterface interface for this use case
+type AssignTracksUCInterface interface {
+	Handle(events models.Events, options models.Options) (models.TrackAssignmentReport, error)
 }
 
 // Handle main method controller to execute this lambda function
 func (h *Handler) Handle(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	switch event.Resource {
+	case resourceConcurrency:
+		return h.handleConcurrency(event)
+	case resourceFreeBusy:
+		return h.handleFreeBusy(event)
+	case resourceMeetingSlots:
+		return h.handleMeetingSlots(event)
+	case resourceCanBook:
+		return h.handleCanBook(event)
+	case resourceDiff:
+		return h.handleDiff(event)
+	case resourceReschedule:
+		return h.handleReschedule(event)
+	case resourceTracks:
+		return h.handleTracks(event)
+	default:
+		return h.handleDoubleBooked(event)
+	}
+}
+
+// handleDoubleBooked find the double booked events of the calendar given
+func (h *Handler) handleDoubleBooked(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
 	var requestBody models.RequestBody
 
 	err := json.Unmarshal([]byte(event.Body), &requestBody)
@@ -36,22 +121,275 @@ func (h *Handler) Handle(event events.APIGatewayProxyRequest) (events.APIGateway
 	}
 
 	// Standardize timezone in the events
-	eventsInUTC, err := h.parseEventsToUTCUC.Handle(requestBody.Events)
+	eventsInUTC, warnings, err := h.parseEventsToUTCUC.Handle(requestBody.Events, requestBody.Options)
 	if err != nil {
 		return responseError(err)
 	}
 
 	// Get the double booked events
-	doubleBookedEvents, err := h.findDoubleBookedEventsUC.Handle(eventsInUTC)
+	doubleBookedReport, err := h.findDoubleBookedEventsUC.Handle(eventsInUTC, requestBody.Options)
 	if err != nil {
 		return responseError(err)
 	}
 
+	doubleBookedReport.Warnings = warnings
+
 	// Prepare and response double booked events
 	responseBody := models.ResponseBody{
-		DoubleBookedEvents: doubleBookedEvents,
+		DoubleBookedReport: doubleBookedReport,
+	}
+
+	return responseOK(responseBody)
+}
+
+// handleConcurrency find how many events of the calendar given run at the same time
+func (h *Handler) handleConcurrency(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.RequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	eventsInUTC, warnings, err := h.parseEventsToUTCUC.Handle(requestBody.Events, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	concurrencyReport, err := h.findPeakConcurrencyUC.Handle(eventsInUTC, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	concurrencyReport.Warnings = warnings
+
+	return responseOK(concurrencyReport)
+}
+
+// handleFreeBusy find the busy blocks and the free gaps of the calendar given inside the window requested
+func (h *Handler) handleFreeBusy(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.FreeBusyRequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	eventsInUTC, warnings, err := h.parseEventsToUTCUC.Handle(requestBody.Events, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	// The window is normalized the same way as the events
+	windowInUTC, windowWarnings, err := h.parseEventsToUTCUC.Handle(
+		models.Events{requestBody.Window(requestBody.Timezone)},
+		requestBody.Options,
+	)
+	if err != nil {
+		return responseError(err)
+	}
+
+	freeBusyReport, err := h.findFreeBusyUC.Handle(eventsInUTC, windowInUTC[0], requestBody.Options)
+	if err != nil {
+		return responseError(err)
 	}
 
+	freeBusyReport.Warnings = append(warnings, windowWarnings...)
+
+	return responseOK(freeBusyReport)
+}
+
+// handleMeetingSlots find the first slots where every participant given is free
+func (h *Handler) handleMeetingSlots(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.MeetingSlotsRequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	// Standardize timezone in the events of every participant
+	participantsInUTC := make(models.Participants, 0, len(requestBody.Participants))
+
+	var warnings models.Warnings
+
+	for _, participant := range requestBody.Participants {
+		var participantWarnings models.Warnings
+
+		participant.Events, participantWarnings, err = h.parseEventsToUTCUC.Handle(
+			participant.Events,
+			requestBody.Options,
+		)
+		if err != nil {
+			return responseError(err)
+		}
+
+		participantsInUTC = append(participantsInUTC, participant)
+		warnings = append(warnings, participantWarnings...)
+	}
+
+	windowInUTC, windowWarnings, err := h.parseEventsToUTCUC.Handle(
+		models.Events{requestBody.Window(requestBody.Timezone)},
+		requestBody.Options,
+	)
+	if err != nil {
+		return responseError(err)
+	}
+
+	meetingSlotsReport, err := h.findMeetingSlotsUC.Handle(
+		participantsInUTC,
+		windowInUTC[0],
+		requestBody.SlotRequest,
+		requestBody.Options,
+	)
+	if err != nil {
+		return responseError(err)
+	}
+
+	meetingSlotsReport.Warnings = append(warnings, windowWarnings...)
+
+	return responseOK(meetingSlotsReport)
+}
+
+// handleCanBook check if the candidate given can be booked in the calendar given
+func (h *Handler) handleCanBook(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.CanBookRequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	eventsInUTC, warnings, err := h.parseEventsToUTCUC.Handle(requestBody.Events, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	// The candidate is normalized the same way as the events, a recurring one gives every occurrence
+	candidateInUTC, candidateWarnings, err := h.parseEventsToUTCUC.Handle(
+		models.Events{requestBody.Candidate},
+		requestBody.Options,
+	)
+	if err != nil {
+		return responseError(err)
+	}
+
+	availabilityReport, err := h.checkAvailabilityUC.Handle(eventsInUTC, candidateInUTC, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	availabilityReport.Warnings = append(warnings, candidateWarnings...)
+
+	return responseOK(availabilityReport)
+}
+
+// handleDiff compare the conflicts of the calendar given before and after the changes
+func (h *Handler) handleDiff(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.DiffRequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	beforeInUTC, beforeWarnings, err := h.parseEventsToUTCUC.Handle(requestBody.Before, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	afterInUTC, afterWarnings, err := h.parseEventsToUTCUC.Handle(requestBody.After, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	calendarDiffReport, err := h.diffCalendarsUC.Handle(beforeInUTC, afterInUTC, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	calendarDiffReport.Warnings = append(beforeWarnings, afterWarnings...)
+
+	return responseOK(calendarDiffReport)
+}
+
+// handleReschedule propose new slots for the events to move out of the conflicts of the calendar given
+func (h *Handler) handleReschedule(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.RescheduleRequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	eventsInUTC, warnings, err := h.parseEventsToUTCUC.Handle(requestBody.Events, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	windowInUTC, windowWarnings, err := h.parseEventsToUTCUC.Handle(
+		models.Events{requestBody.Window(requestBody.Timezone)},
+		requestBody.Options,
+	)
+	if err != nil {
+		return responseError(err)
+	}
+
+	warnings = append(warnings, windowWarnings...)
+
+	// Now is given in the timezone of the window, so it is normalized the same way
+	if requestBody.Now != "" {
+		nowInUTC, nowWarnings, err := h.parseEventsToUTCUC.Handle(models.Events{requestBody.NowWindow()}, requestBody.Options)
+		if err != nil {
+			return responseError(err)
+		}
+
+		warnings = append(warnings, nowWarnings...)
+
+		requestBody.Now = nowInUTC[0].Start
+	}
+
+	rescheduleReport, err := h.proposeReschedulesUC.Handle(
+		eventsInUTC,
+		windowInUTC[0],
+		requestBody.RescheduleRequest,
+		requestBody.Options,
+	)
+	if err != nil {
+		return responseError(err)
+	}
+
+	rescheduleReport.Warnings = warnings
+
+	return responseOK(rescheduleReport)
+}
+
+// handleTracks assign the events of the calendar given to the minimum number of tracks without overlaps
+func (h *Handler) handleTracks(event events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	var requestBody models.RequestBody
+
+	err := json.Unmarshal([]byte(event.Body), &requestBody)
+	if err != nil {
+		return responseError(err)
+	}
+
+	eventsInUTC, warnings, err := h.parseEventsToUTCUC.Handle(requestBody.Events, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	trackAssignmentReport, err := h.assignTracksUC.Handle(eventsInUTC, requestBody.Options)
+	if err != nil {
+		return responseError(err)
+	}
+
+	trackAssignmentReport.Warnings = warnings
+
+	return responseOK(trackAssignmentReport)
+}
+
+// responseOK return the response with the body given
+func responseOK(responseBody interface{}) (events.APIGatewayProxyResponse, error) {
 	responseJSON, err := json.Marshal(responseBody)
 	if err != nil {
 		return responseError(err)
@@ -108,9 +446,23 @@ func responseError(err error) (events.APIGatewayProxyResponse, error) {
 func NewHandler(
 	findDoubleBookedEventsUC FindDoubleBookedEventsUCInterface,
 	parseEventsToUTCUC ParseEventsToUTCUCInterface,
+	findPeakConcurrencyUC FindPeakConcurrencyUCInterface,
+	findFreeBusyUC FindFreeBusyUCInterface,
+	findMeetingSlotsUC FindMeetingSlotsUCInterface,
+	checkAvailabilityUC CheckAvailabilityUCInterface,
+	diffCalendarsUC DiffCalendarsUCInterface,
+	proposeReschedulesUC ProposeReschedulesUCInterface,
+	assignTracksUC AssignTracksUCInterface,
 ) *Handler {
 	return &Handler{
 		findDoubleBookedEventsUC: findDoubleBookedEventsUC,
 		parseEventsToUTCUC:       parseEventsToUTCUC,
+		findPeakConcurrencyUC:    findPeakConcurrencyUC,
+		findFreeBusyUC:           findFreeBusyUC,
+		findMeetingSlotsUC:       findMeetingSlotsUC,
+		checkAvailabilityUC:      checkAvailabilityUC,
+		diffCalendarsUC:          diffCalendarsUC,
+		proposeReschedulesUC:     proposeReschedulesUC,
+		assignTracksUC:           assignTracksUC,
 	}
 }
@@ -23,10 +23,13 @@ type findDoubleBookedEventsUCMock struct {
 }
 
 // Handle mock for this method
-func (m *findDoubleBookedEventsUCMock) Handle(events models.Events) (models.DoubleBookedEvents, error) {
-	args := m.Called(events)
+func (m *findDoubleBookedEventsUCMock) Handle(
+	events models.Events,
+	options models.Options,
+) (models.DoubleBookedReport, error) {
+	args := m.Called(events, options)
 
-	return args.Get(0).(models.DoubleBookedEvents), args.Error(1)
+	return args.Get(0).(models.DoubleBookedReport), args.Error(1)
 }
 
 // parseEventsToUTCUCMock mock for this use case
@@ -35,10 +38,126 @@ type parseEventsToUTCUCMock struct {
 }
 
 // Handle mock for this method
-func (m *parseEventsToUTCUCMock) Handle(events models.Events) (models.Events, error) {
-	args := m.Called(events)
+func (m *parseEventsToUTCUCMock) Handle(
+	events models.Events,
+	options models.Options,
+) (models.Events, models.Warnings, error) {
+	args := m.Called(events, options)
+	warnings, _ := args.Get(1).(models.Warnings)
 
-	return args.Get(0).(models.Events), args.Error(1)
+	return args.Get(0).(models.Events), warnings, args.Error(2)
+}
+
+// findPeakConcurrencyUCMock mock for this use case
+type findPeakConcurrencyUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *findPeakConcurrencyUCMock) Handle(
+	events models.Events,
+	options models.Options,
+) (models.ConcurrencyReport, error) {
+	args := m.Called(events, options)
+
+	return args.Get(0).(models.ConcurrencyReport), args.Error(1)
+}
+
+// findFreeBusyUCMock mock for this use case
+type findFreeBusyUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *findFreeBusyUCMock) Handle(
+	events models.Events,
+	window models.Event,
+	options models.Options,
+) (models.FreeBusyReport, error) {
+	args := m.Called(events, window, options)
+
+	return args.Get(0).(models.FreeBusyReport), args.Error(1)
+}
+
+// findMeetingSlotsUCMock mock for this use case
+type findMeetingSlotsUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *findMeetingSlotsUCMock) Handle(
+	participants models.Participants,
+	window models.Event,
+	slotRequest models.SlotRequest,
+	options models.Options,
+) (models.MeetingSlotsReport, error) {
+	args := m.Called(participants, window, slotRequest, options)
+
+	return args.Get(0).(models.MeetingSlotsReport), args.Error(1)
+}
+
+// checkAvailabilityUCMock mock for this use case
+type checkAvailabilityUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *checkAvailabilityUCMock) Handle(
+	events models.Events,
+	candidate models.Events,
+	options models.Options,
+) (models.AvailabilityReport, error) {
+	args := m.Called(events, candidate, options)
+
+	return args.Get(0).(models.AvailabilityReport), args.Error(1)
+}
+
+// diffCalendarsUCMock mock for this use case
+type diffCalendarsUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *diffCalendarsUCMock) Handle(
+	before models.Events,
+	after models.Events,
+	options models.Options,
+) (models.CalendarDiffReport, error) {
+	args := m.Called(before, after, options)
+
+	return args.Get(0).(models.CalendarDiffReport), args.Error(1)
+}
+
+// proposeReschedulesUCMock mock for this use case
+type proposeReschedulesUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *proposeReschedulesUCMock) Handle(
+	events models.Events,
+	window models.Event,
+	request models.RescheduleRequest,
+	options models.Options,
+) (models.RescheduleReport, error) {
+	args := m.Called(events, window, request, options)
+
+	return args.Get(0).(models.RescheduleReport), args.Error(1)
+}
+
+// assignTracksUCMock mock for this use case
+type assignTracksUCMock struct {
+	mock.Mock
+}
+
+// Handle mock for this method
+func (m *assignTracksUCMock) Handle(
+	events models.Events,
+	options models.Options,
+) (models.TrackAssignmentReport, error) {
+	args := m.Called(events, options)
+
+	return args.Get(0).(models.TrackAssignmentReport), args.Error(1)
 }
 
 // getDataFromGoldenFile This method reads the golden file located in the path given and return the content (string)
@@ -63,6 +182,13 @@ func TestHandler_Handle(t *testing.T) {
 	type fields struct {
 		findDoubleBookedEventsUC *findDoubleBookedEventsUCMock
 		parseEventsToUTCUC       *parseEventsToUTCUCMock
+		findPeakConcurrencyUC    *findPeakConcurrencyUCMock
+		findFreeBusyUC           *findFreeBusyUCMock
+		findMeetingSlotsUC       *findMeetingSlotsUCMock
+		checkAvailabilityUC      *checkAvailabilityUCMock
+		diffCalendarsUC          *diffCalendarsUCMock
+		proposeReschedulesUC     *proposeReschedulesUCMock
+		assignTracksUC           *assignTracksUCMock
 	}
 
 	type args struct {
@@ -112,6 +238,13 @@ func TestHandler_Handle(t *testing.T) {
 			fields: fields{
 				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
 				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
 			},
 			args: args{
 				event: events.APIGatewayProxyRequest{
@@ -128,9 +261,53 @@ func TestHandler_Handle(t *testing.T) {
 			},
 			wantErr: false,
 			mock: func(f fields) {
-				f.parseEventsToUTCUC.On("Handle", eventsInBogota).Once().Return(eventsInUTC, nil)
-				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC).Once().
-					Return(models.DoubleBookedEvents{}, nil)
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC, models.Options{}).Once().
+					Return(models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{}}, nil)
+			},
+		},
+		{
+			name: "Success with DST warnings",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Body: getDataFromGoldenFile(
+						"./testdata/no_double_booked_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/dst_warning_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().
+					Return(eventsInUTC, models.Warnings{
+						{
+							Code:        models.WarningCodeDSTGap,
+							Event:       1,
+							LocalTime:   "2023-03-12 02:30",
+							Timezone:    "America/New_York",
+							ResolvedUTC: "2023-03-12 06:30",
+							Message: "Local time 2023-03-12 02:30 does not exist because of a DST change in " +
+								"America/New_York, the earlier instant 2023-03-12 06:30 UTC is used",
+						},
+					}, nil)
+				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC, models.Options{}).Once().
+					Return(models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{}}, nil)
 			},
 		},
 		{
@@ -138,6 +315,13 @@ func TestHandler_Handle(t *testing.T) {
 			fields: fields{
 				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
 				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
 			},
 			args: args{
 				event: events.APIGatewayProxyRequest{
@@ -154,9 +338,554 @@ func TestHandler_Handle(t *testing.T) {
 			},
 			wantErr: false,
 			mock: func(f fields) {
-				f.parseEventsToUTCUC.On("Handle", eventsInBogota).Once().Return(eventsInUTC, nil)
-				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC).Once().
-					Return(models.DoubleBookedEvents{}, nil)
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC, models.Options{}).Once().
+					Return(models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{}}, nil)
+			},
+		},
+		{
+			name: "Success with overlap mode",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Body: getDataFromGoldenFile(
+						"./testdata/double_booked_closed_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/double_booked_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{OverlapMode: models.OverlapModeClosed}).Once().Return(eventsInUTC, nil, nil)
+				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC, models.Options{
+					OverlapMode: models.OverlapModeClosed,
+				}).Once().Return(models.DoubleBookedReport{
+					DoubleBookedEvents: models.DoubleBookedEvents{{1, 3}, {2, 3}},
+				}, nil)
+			},
+		},
+		{
+			name: "Success with conflict details",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Body: getDataFromGoldenFile(
+						"./testdata/double_booked_details_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/double_booked_details_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{IncludeDetails: true, Timezone: "America/Bogota"}).Once().Return(eventsInUTC, nil, nil)
+				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC, models.Options{
+					IncludeDetails: true,
+					Timezone:       "America/Bogota",
+				}).Once().Return(models.DoubleBookedReport{
+					DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}},
+					Conflicts: models.Conflicts{
+						{
+							Events: []int{1, 2},
+							OverlapUTC: models.TimeWindow{
+								Start:    "2023-02-02 21:00",
+								End:      "2023-02-02 21:30",
+								Timezone: "UTC",
+							},
+							OverlapLocal: models.TimeWindow{
+								Start:    "2023-02-02 16:00",
+								End:      "2023-02-02 16:30",
+								Timezone: "America/Bogota",
+							},
+							OverlapSeconds: 1800,
+							Severity:       models.SeverityCritical,
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success with peak concurrency",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceConcurrency,
+					Body: getDataFromGoldenFile(
+						"./testdata/no_double_booked_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/concurrency_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.findPeakConcurrencyUC.On("Handle", eventsInUTC, models.Options{}).Once().
+					Return(models.ConcurrencyReport{
+						Peak: 1,
+						PeakWindows: []models.TimeWindow{
+							{Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: "UTC"},
+							{Start: "2023-02-02 21:00", End: "2023-02-02 23:00", Timezone: "UTC"},
+						},
+						Timeline: []models.ConcurrencyStep{
+							{
+								Window: models.TimeWindow{
+									Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: "UTC",
+								},
+								Count: 1,
+							},
+							{
+								Window: models.TimeWindow{
+									Start: "2023-02-02 19:00", End: "2023-02-02 21:00", Timezone: "UTC",
+								},
+								Count: 0,
+							},
+							{
+								Window: models.TimeWindow{
+									Start: "2023-02-02 21:00", End: "2023-02-02 23:00", Timezone: "UTC",
+								},
+								Count: 1,
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "Fail peak concurrency by parse events to utc",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceConcurrency,
+					Body: getDataFromGoldenFile(
+						"./testdata/request_with_wrong_location.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: models.CodeStatusHTTPBusinessError,
+				Body: getDataFromGoldenFile(
+					"./testdata/response_parse_event_error.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", mock.Anything, mock.Anything).Once().Return(models.Events{}, nil, &models.EventError{
+					Code: models.CodeParseEventError,
+					ID:   models.IDDoubleBookedError,
+					Message: fmt.Sprintf("Error setting timezone of event %v",
+						models.Event{
+							ID:       1,
+							Start:    "2023-02-02 13:00",
+							End:      "2023-02-02 14:00",
+							Timezone: "WRONG",
+						}),
+					StatusCode: models.CodeStatusHTTPBusinessError,
+				})
+			},
+		},
+		{
+			name: "Success with free busy",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceFreeBusy,
+					Body: getDataFromGoldenFile(
+						"./testdata/free_busy_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/free_busy_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{Timezone: "America/Bogota"}).Once().Return(eventsInUTC, nil, nil)
+				f.parseEventsToUTCUC.On("Handle", models.Events{
+					models.Event{
+						Start:    "2023-02-02 12:00",
+						End:      "2023-02-02 17:00",
+						Timezone: "America/Bogota",
+					},
+				}, models.Options{Timezone: "America/Bogota"}).Once().Return(models.Events{
+					models.Event{
+						Start:    "2023-02-02 17:00",
+						End:      "2023-02-02 22:00",
+						Timezone: "UTC",
+					},
+				}, nil, nil)
+				f.findFreeBusyUC.On("Handle", eventsInUTC, models.Event{
+					Start:    "2023-02-02 17:00",
+					End:      "2023-02-02 22:00",
+					Timezone: "UTC",
+				}, models.Options{Timezone: "America/Bogota"}).Once().Return(models.FreeBusyReport{
+					Window: models.TimeWindow{
+						Start: "2023-02-02 12:00", End: "2023-02-02 17:00", Timezone: "America/Bogota",
+					},
+					Busy: []models.TimeWindow{
+						{Start: "2023-02-02 13:00", End: "2023-02-02 14:00", Timezone: "America/Bogota"},
+						{Start: "2023-02-02 16:00", End: "2023-02-02 17:00", Timezone: "America/Bogota"},
+					},
+					Free: []models.TimeWindow{
+						{Start: "2023-02-02 12:00", End: "2023-02-02 13:00", Timezone: "America/Bogota"},
+						{Start: "2023-02-02 14:00", End: "2023-02-02 16:00", Timezone: "America/Bogota"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success with meeting slots",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceMeetingSlots,
+					Body: getDataFromGoldenFile(
+						"./testdata/meeting_slots_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/meeting_slots_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{Timezone: "America/Bogota"}).Once().Return(eventsInUTC, nil, nil)
+				f.parseEventsToUTCUC.On("Handle", models.Events{
+					models.Event{
+						Start:    "2023-02-02 09:00",
+						End:      "2023-02-02 18:00",
+						Timezone: "America/Bogota",
+					},
+				}, models.Options{Timezone: "America/Bogota"}).Once().Return(models.Events{
+					models.Event{
+						Start:    "2023-02-02 14:00",
+						End:      "2023-02-02 23:00",
+						Timezone: "UTC",
+					},
+				}, nil, nil)
+				f.findMeetingSlotsUC.On("Handle", models.Participants{
+					{
+						ID:           "ana",
+						Timezone:     "America/Bogota",
+						WorkingHours: models.WorkingHours{Start: "09:00", End: "17:00"},
+						Events:       eventsInUTC,
+					},
+				}, models.Event{
+					Start:    "2023-02-02 14:00",
+					End:      "2023-02-02 23:00",
+					Timezone: "UTC",
+				}, models.SlotRequest{DurationMinutes: 60, Slots: 2}, models.Options{
+					Timezone: "America/Bogota",
+				}).Once().Return(models.MeetingSlotsReport{
+					Slots: []models.TimeWindow{
+						{Start: "2023-02-02 09:00", End: "2023-02-02 10:00", Timezone: "America/Bogota"},
+						{Start: "2023-02-02 10:00", End: "2023-02-02 11:00", Timezone: "America/Bogota"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "Success with availability check",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceCanBook,
+					Body: getDataFromGoldenFile(
+						"./testdata/can_book_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/can_book_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				candidateInUTC := models.Events{
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 18:30",
+						End:      "2023-02-02 19:30",
+						Timezone: "UTC",
+					},
+				}
+
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.parseEventsToUTCUC.On("Handle", models.Events{
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 13:30",
+						End:      "2023-02-02 14:30",
+						Timezone: "America/Bogota",
+					},
+				}, models.Options{}).Once().Return(candidateInUTC, nil, nil)
+				f.checkAvailabilityUC.On("Handle", eventsInUTC, candidateInUTC, models.Options{}).Once().
+					Return(models.AvailabilityReport{
+						Available:         false,
+						ConflictingEvents: []int{1},
+						Conflicts: models.Conflicts{
+							{
+								Events: []int{3, 1},
+								OverlapUTC: models.TimeWindow{
+									Start:    "2023-02-02 18:30",
+									End:      "2023-02-02 19:00",
+									Timezone: "UTC",
+								},
+								OverlapLocal: models.TimeWindow{
+									Start:    "2023-02-02 18:30",
+									End:      "2023-02-02 19:00",
+									Timezone: "UTC",
+								},
+								OverlapSeconds: 1800,
+								Severity:       models.SeverityWarning,
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "Success with calendar diff",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceDiff,
+					Body: getDataFromGoldenFile(
+						"./testdata/diff_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/diff_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota[:1], models.Options{}).Once().
+					Return(eventsInUTC[:1], nil, nil)
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.diffCalendarsUC.On("Handle", eventsInUTC[:1], eventsInUTC, models.Options{}).Once().
+					Return(models.CalendarDiffReport{
+						Introduced:     models.Conflicts{},
+						Resolved:       models.Conflicts{},
+						Unchanged:      models.Conflicts{},
+						AddedEvents:    []int{2},
+						RemovedEvents:  []int{},
+						ModifiedEvents: []int{},
+					}, nil)
+			},
+		},
+		{
+			name: "Success with rescheduling proposals",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceReschedule,
+					Body: getDataFromGoldenFile(
+						"./testdata/reschedule_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/reschedule_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				window := models.Event{Start: "2023-02-03 08:00", End: "2023-02-03 18:00", Timezone: "America/Bogota"}
+				windowInUTC := models.Event{Start: "2023-02-03 13:00", End: "2023-02-03 23:00", Timezone: "UTC"}
+				now := models.Event{Start: "2023-02-02 12:00", End: "2023-02-02 12:00", Timezone: "America/Bogota"}
+				nowInUTC := models.Event{Start: "2023-02-02 17:00", End: "2023-02-02 17:00", Timezone: "UTC"}
+
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.parseEventsToUTCUC.On("Handle", models.Events{window}, models.Options{}).Once().
+					Return(models.Events{windowInUTC}, nil, nil)
+				f.parseEventsToUTCUC.On("Handle", models.Events{now}, models.Options{}).Once().
+					Return(models.Events{nowInUTC}, nil, nil)
+				f.proposeReschedulesUC.On("Handle", eventsInUTC, windowInUTC, models.RescheduleRequest{
+					WorkingHours:         models.WorkingHours{Start: "09:00", End: "17:00"},
+					MinimumNoticeMinutes: 60,
+					Now:                  "2023-02-02 17:00",
+					Suggestions:          1,
+				}, models.Options{}).Once().
+					Return(models.RescheduleReport{
+						Proposals: []models.RescheduleProposal{
+							{
+								Event:         2,
+								Priority:      1,
+								ConflictsWith: []int{1},
+								Alternatives: []models.RescheduleSlot{
+									{
+										Rank: 1,
+										SlotUTC: models.TimeWindow{
+											Start:    "2023-02-03 09:00",
+											End:      "2023-02-03 11:00",
+											Timezone: "UTC",
+										},
+										SlotLocal: models.TimeWindow{
+											Start:    "2023-02-03 09:00",
+											End:      "2023-02-03 11:00",
+											Timezone: "UTC",
+										},
+										ShiftMinutes: 720,
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "Success with track assignment",
+			fields: fields{
+				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
+				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
+			},
+			args: args{
+				event: events.APIGatewayProxyRequest{
+					Resource: resourceTracks,
+					Body: getDataFromGoldenFile(
+						"./testdata/no_double_booked_request.golden",
+					),
+				},
+			},
+			want: events.APIGatewayProxyResponse{
+				StatusCode: http.StatusOK,
+				Body: getDataFromGoldenFile(
+					"./testdata/tracks_response.golden",
+				),
+			},
+			wantErr: false,
+			mock: func(f fields) {
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.assignTracksUC.On("Handle", eventsInUTC, models.Options{}).Once().
+					Return(models.TrackAssignmentReport{
+						Tracks: 1,
+						Assignments: []models.TrackAssignment{
+							{Track: 1, Event: 1},
+							{Track: 1, Event: 2},
+						},
+					}, nil)
 			},
 		},
 		{
@@ -164,6 +893,13 @@ func TestHandler_Handle(t *testing.T) {
 			fields: fields{
 				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
 				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
 			},
 			args: args{
 				event: events.APIGatewayProxyRequest{
@@ -187,7 +923,7 @@ func TestHandler_Handle(t *testing.T) {
 						End:      "2023-02-02 14:00",
 						Timezone: "WRONG",
 					},
-				}).Once().Return(models.Events{}, &models.EventError{
+				}, models.Options{}).Once().Return(models.Events{}, nil, &models.EventError{
 					Code: models.CodeParseEventError,
 					ID:   models.IDDoubleBookedError,
 					Message: fmt.Sprintf("Error setting timezone of event %v",
@@ -206,6 +942,13 @@ func TestHandler_Handle(t *testing.T) {
 			fields: fields{
 				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
 				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
 			},
 			args: args{
 				event: events.APIGatewayProxyRequest{
@@ -222,14 +965,14 @@ func TestHandler_Handle(t *testing.T) {
 			},
 			wantErr: false,
 			mock: func(f fields) {
-				f.parseEventsToUTCUC.On("Handle", eventsInBogota).Once().Return(models.Events{
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(models.Events{
 					models.Event{
 						ID:       1,
 						Start:    "2023-02-02 18:00",
 						End:      "2023-02-02 19:00",
 						Timezone: "UTC",
 					},
-				}, nil)
+				}, nil, nil)
 				f.findDoubleBookedEventsUC.On("Handle", models.Events{
 					models.Event{
 						ID:       1,
@@ -237,8 +980,8 @@ func TestHandler_Handle(t *testing.T) {
 						End:      "2023-02-02 19:00",
 						Timezone: "UTC",
 					},
-				}).Once().
-					Return(models.DoubleBookedEvents{}, &models.EventError{
+				}, models.Options{}).Once().
+					Return(models.DoubleBookedReport{}, &models.EventError{
 						Code: models.CodeFindDoubleBookedError,
 						ID:   models.IDDoubleBookedError,
 						Message: fmt.Sprintf("Error parsing timezone of UTC event %v", models.Event{
@@ -256,6 +999,13 @@ func TestHandler_Handle(t *testing.T) {
 			fields: fields{
 				findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
 				parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+				findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+				findFreeBusyUC:           &findFreeBusyUCMock{},
+				findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+				checkAvailabilityUC:      &checkAvailabilityUCMock{},
+				diffCalendarsUC:          &diffCalendarsUCMock{},
+				proposeReschedulesUC:     &proposeReschedulesUCMock{},
+				assignTracksUC:           &assignTracksUCMock{},
 			},
 			args: args{
 				event: events.APIGatewayProxyRequest{
@@ -272,9 +1022,9 @@ func TestHandler_Handle(t *testing.T) {
 			},
 			wantErr: true,
 			mock: func(f fields) {
-				f.parseEventsToUTCUC.On("Handle", eventsInBogota).Once().Return(eventsInUTC, nil)
-				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC).Once().
-					Return(models.DoubleBookedEvents{}, errors.New("error"))
+				f.parseEventsToUTCUC.On("Handle", eventsInBogota, models.Options{}).Once().Return(eventsInUTC, nil, nil)
+				f.findDoubleBookedEventsUC.On("Handle", eventsInUTC, models.Options{}).Once().
+					Return(models.DoubleBookedReport{}, errors.New("error"))
 			},
 		},
 	}
@@ -285,6 +1035,13 @@ func TestHandler_Handle(t *testing.T) {
 			h := &Handler{
 				findDoubleBookedEventsUC: tt.fields.findDoubleBookedEventsUC,
 				parseEventsToUTCUC:       tt.fields.parseEventsToUTCUC,
+				findPeakConcurrencyUC:    tt.fields.findPeakConcurrencyUC,
+				findFreeBusyUC:           tt.fields.findFreeBusyUC,
+				findMeetingSlotsUC:       tt.fields.findMeetingSlotsUC,
+				checkAvailabilityUC:      tt.fields.checkAvailabilityUC,
+				diffCalendarsUC:          tt.fields.diffCalendarsUC,
+				proposeReschedulesUC:     tt.fields.proposeReschedulesUC,
+				assignTracksUC:           tt.fields.assignTracksUC,
 			}
 			got, err := h.Handle(tt.args.event)
 			if (err != nil) != tt.wantErr {
@@ -306,11 +1063,25 @@ func TestNewHandler(t *testing.T) {
 	type args struct {
 		findDoubleBookedEventsUC FindDoubleBookedEventsUCInterface
 		parseEventsToUTCUC       ParseEventsToUTCUCInterface
+		findPeakConcurrencyUC    FindPeakConcurrencyUCInterface
+		findFreeBusyUC           FindFreeBusyUCInterface
+		findMeetingSlotsUC       FindMeetingSlotsUCInterface
+		checkAvailabilityUC      CheckAvailabilityUCInterface
+		diffCalendarsUC          DiffCalendarsUCInterface
+		proposeReschedulesUC     ProposeReschedulesUCInterface
+		assignTracksUC           AssignTracksUCInterface
 	}
 
 	arguments := args{
 		findDoubleBookedEventsUC: &findDoubleBookedEventsUCMock{},
 		parseEventsToUTCUC:       &parseEventsToUTCUCMock{},
+		findPeakConcurrencyUC:    &findPeakConcurrencyUCMock{},
+		findFreeBusyUC:           &findFreeBusyUCMock{},
+		findMeetingSlotsUC:       &findMeetingSlotsUCMock{},
+		checkAvailabilityUC:      &checkAvailabilityUCMock{},
+		diffCalendarsUC:          &diffCalendarsUCMock{},
+		proposeReschedulesUC:     &proposeReschedulesUCMock{},
+		assignTracksUC:           &assignTracksUCMock{},
 	}
 	tests := []struct {
 		name string
@@ -323,6 +1094,13 @@ func TestNewHandler(t *testing.T) {
 			want: NewHandler(
 				arguments.findDoubleBookedEventsUC,
 				arguments.parseEventsToUTCUC,
+				arguments.findPeakConcurrencyUC,
+				arguments.findFreeBusyUC,
+				arguments.findMeetingSlotsUC,
+				arguments.checkAvailabilityUC,
+				arguments.diffCalendarsUC,
+				arguments.proposeReschedulesUC,
+				arguments.assignTracksUC,
 			),
 		},
 	}
@@ -334,6 +1112,13 @@ func TestNewHandler(t *testing.T) {
 			if got := NewHandler(
 				tt.args.findDoubleBookedEventsUC,
 				tt.args.parseEventsToUTCUC,
+				tt.args.findPeakConcurrencyUC,
+				tt.args.findFreeBusyUC,
+				tt.args.findMeetingSlotsUC,
+				tt.args.checkAvailabilityUC,
+				tt.args.diffCalendarsUC,
+				tt.args.proposeReschedulesUC,
+				tt.args.assignTracksUC,
 			); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewHandler() = %v, want %v", got, tt.want)
 			}
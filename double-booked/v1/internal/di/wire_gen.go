@@ -17,6 +17,13 @@ import (
 func Initialize() (*internal.Handler, error) {
 	findDoubleBookedEventsUC := uc.NewFindDoubleBookedEventsUC()
 	parseEventsToUTCUC := uc.NewParseEventsToUTCUC()
-	handler := internal.NewHandler(findDoubleBookedEventsUC, parseEventsToUTCUC)
+	findPeakConcurrencyUC := uc.NewFindPeakConcurrencyUC()
+	findFreeBusyUC := uc.NewFindFreeBusyUC()
+	findMeetingSlotsUC := uc.NewFindMeetingSlotsUC()
+	checkAvailabilityUC := uc.NewCheckAvailabilityUC()
+	diffCalendarsUC := uc.NewDiffCalendarsUC()
+	proposeReschedulesUC := uc.NewProposeReschedulesUC()
+	assignTracksUC := uc.NewAssignTracksUC()
+	handler := internal.NewHandler(findDoubleBookedEventsUC, parseEventsToUTCUC, findPeakConcurrencyUC, findFreeBusyUC, findMeetingSlotsUC, checkAvailabilityUC, diffCalendarsUC, proposeReschedulesUC, assignTracksUC)
 	return handler, nil
 }
@@ -15,8 +15,22 @@ var stdSet = wire.NewSet(
 	newAWSSessionProvider,
 	uc.NewFindDoubleBookedEventsUC,
 	uc.NewParseEventsToUTCUC,
+	uc.NewFindPeakConcurrencyUC,
+	uc.NewFindFreeBusyUC,
+	uc.NewFindMeetingSlotsUC,
+	uc.NewCheckAvailabilityUC,
+	uc.NewDiffCalendarsUC,
+	uc.NewProposeReschedulesUC,
+	uc.NewAssignTracksUC,
 	internal.NewHandler,
 
 	wire.Bind(new(internal.FindDoubleBookedEventsUCInterface), new(*uc.FindDoubleBookedEventsUC)),
 	wire.Bind(new(internal.ParseEventsToUTCUCInterface), new(*uc.ParseEventsToUTCUC)),
+	wire.Bind(new(internal.FindPeakConcurrencyUCInterface), new(*uc.FindPeakConcurrencyUC)),
+	wire.Bind(new(internal.FindFreeBusyUCInterface), new(*uc.FindFreeBusyUC)),
+	wire.Bind(new(internal.FindMeetingSlotsUCInterface), new(*uc.FindMeetingSlotsUC)),
+	wire.Bind(new(internal.CheckAvailabilityUCInterface), new(*uc.CheckAvailabilityUC)),
+	wire.Bind(new(internal.DiffCalendarsUCInterface), new(*uc.DiffCalendarsUC)),
+	wire.Bind(new(internal.ProposeReschedulesUCInterface), new(*uc.ProposeReschedulesUC)),
+	wire.Bind(new(internal.AssignTracksUCInterface), new(*uc.AssignTracksUC)),
 )
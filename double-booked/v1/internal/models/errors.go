@@ -7,6 +7,10 @@ const (
 	CodeFindDoubleBookedError string = "CODE_FIND_DOUBLE_BOOKED_ERROR"
 	// CodeParseEventError error related to double booked
 	CodeParseEventError string = "CODE_PARSE_EVENT_ERROR"
+	// CodeInvalidOptionsError error related to the options given in the request
+	CodeInvalidOptionsError string = "CODE_INVALID_OPTIONS_ERROR"
+	// CodeInvalidWindowError error related to the window of time given in the request
+	CodeInvalidWindowError string = "CODE_INVALID_WINDOW_ERROR"
 	// IDDoubleBookedError error related to double booked
 	IDDoubleBookedError string = "ID_DOUBLE_BOOKED_ERROR"
 	// CodeGeneralError Unexpected errors code
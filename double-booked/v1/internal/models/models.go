@@ -1,26 +1,531 @@
 // Package internal have all the main logic
 package models
 
+import "fmt"
+
 // RequestBody struct for request body
 type RequestBody struct {
 	Events Events `json:"events"`
+	Options
+}
+
+// FreeBusyRequestBody struct for the free/busy request body
+type FreeBusyRequestBody struct {
+	RequestBody
+	SearchWindow
+}
+
+// CanBookRequestBody struct for the availability check request body, the candidate is the event to book
+type CanBookRequestBody struct {
+	RequestBody
+	Candidate Event `json:"candidate"`
+}
+
+// DiffRequestBody struct for the calendar diff request body, with the events before and after the changes
+type DiffRequestBody struct {
+	Before Events `json:"before"`
+	After  Events `json:"after"`
+	Options
+}
+
+// RescheduleRequestBody struct for the rescheduling request body, the window is the date range allowed for the
+// new slots
+type RescheduleRequestBody struct {
+	RequestBody
+	SearchWindow
+	RescheduleRequest
+}
+
+// RescheduleRequest declare the rules of the new slots proposed to the events to move. The working hours are in
+// the timezone of the options, the notice is counted from now, given in the timezone of the window and by
+// default the current time, and suggestions is the number of alternatives per event, by default 3
+type RescheduleRequest struct {
+	WorkingHours         WorkingHours `json:"working_hours"`
+	MinimumNoticeMinutes int          `json:"minimum_notice_minutes"`
+	Now                  string       `json:"now"`
+	Suggestions          int          `json:"suggestions"`
+}
+
+// NowWindow get the now given as a zero length window, so it can be normalized to UTC like the window
+func (r RescheduleRequestBody) NowWindow() Event {
+	return SearchWindow{WindowStart: r.Now, WindowEnd: r.Now, WindowTimezone: r.WindowTimezone}.Window(r.Timezone)
+}
+
+// MeetingSlotsRequestBody struct for the meeting slots request body
+type MeetingSlotsRequestBody struct {
+	Participants Participants `json:"participants"`
+	SearchWindow
+	SlotRequest
+	Options
+}
+
+// Participants declare a list of participants of a meeting
+type Participants []Participant
+
+// Participant declare the calendar of a participant, the working hours are in the participant timezone
+type Participant struct {
+	ID           string       `json:"id"`
+	Timezone     string       `json:"timezone"`
+	WorkingHours WorkingHours `json:"working_hours"`
+	Events       Events       `json:"events"`
+}
+
+// WorkingHours declare the hours of the day, in "15:04" format, and the week days a participant is available,
+// when they are empty the whole day and every day of the week are available
+type WorkingHours struct {
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+	Days  []string `json:"days"`
+}
+
+// SlotRequest declare the length of the slots requested and how many of them are returned, by default one
+type SlotRequest struct {
+	DurationMinutes int `json:"duration_minutes"`
+	Slots           int `json:"slots"`
+}
+
+// SearchWindow declare a window of time given in the request, it is given in window_timezone or, when it is
+// empty, in the timezone of the options
+type SearchWindow struct {
+	WindowStart    string `json:"window_start"`
+	WindowEnd      string `json:"window_end"`
+	WindowTimezone string `json:"window_timezone"`
+}
+
+// Window get the window requested as an event, so it can be normalized to UTC like the rest of the events
+func (w SearchWindow) Window(defaultTimezone string) Event {
+	timezone := w.WindowTimezone
+	if timezone == "" {
+		timezone = defaultTimezone
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	return Event{
+		Start:    w.WindowStart,
+		End:      w.WindowEnd,
+		Timezone: timezone,
+	}
+}
+
+// Options declare the settings given in the request to tune the double booked detection
+type Options struct {
+	OverlapMode     OverlapMode `json:"overlap_mode"`
+	Sort            SortOrder   `json:"sort"`
+	IncludeDetails  bool        `json:"include_details"`
+	IncludeClusters bool        `json:"include_clusters"`
+	Timezone        string      `json:"timezone"`
+	Resources       Resources   `json:"resources"`
+	// HorizonStart and HorizonEnd bound the expansion of the recurring events, in the timezone of the options
+	HorizonStart string `json:"horizon_start"`
+	HorizonEnd   string `json:"horizon_end"`
+	// ExcludeAllDay leaves the all-day events out of the conflicts
+	ExcludeAllDay bool `json:"exclude_all_day"`
+	// BufferBefore and BufferAfter are the default buffers of the events
+	BufferBefore string `json:"buffer_before"`
+	BufferAfter  string `json:"buffer_after"`
+	// ToleranceMinutes and TolerancePercent of the shorter event are the overlaps that are not double booked
+	ToleranceMinutes   int                `json:"tolerance_minutes"`
+	TolerancePercent   float64            `json:"tolerance_percent"`
+	SeverityThresholds SeverityThresholds `json:"severity_thresholds"`
+	MinSeverity        Severity           `json:"min_severity"`
+	// Statuses that take part in conflicts, by default confirmed and tentative
+	Statuses []EventStatus `json:"statuses"`
+	// IncludeTransparent makes the transparent events take part in conflicts too
+	IncludeTransparent bool      `json:"include_transparent"`
+	IncludeResolution  bool      `json:"include_resolution"`
+	DSTPolicy          DSTPolicy `json:"dst_policy"`
+}
+
+// SeverityThresholds declare the percentages of the shorter event that an overlap must cover to be a warning or
+// a critical conflict, by default 25 and 75
+type SeverityThresholds struct {
+	WarningPercent  float64 `json:"warning_percent"`
+	CriticalPercent float64 `json:"critical_percent"`
+}
+
+// Severity declare how serious a double booking is
+type Severity string
+
+// List of severities supported, from the lowest to the highest
+const (
+	// SeverityInfo the overlap covers less than the warning threshold of the shorter event
+	SeverityInfo Severity = "info"
+	// SeverityWarning the overlap covers less than the critical threshold of the shorter event
+	SeverityWarning Severity = "warning"
+	// SeverityCritical the overlap covers at least the critical threshold of the shorter event
+	SeverityCritical Severity = "critical"
+)
+
+// DSTPolicy declare the instant chosen for a local time that is skipped or repeated by a DST change
+type DSTPolicy string
+
+// List of DST policies supported
+const (
+	// DSTPolicyEarlier the earlier of the instants around the DST change is chosen, it is the default
+	DSTPolicyEarlier DSTPolicy = "earlier"
+	// DSTPolicyLater the later of the instants around the DST change is chosen
+	DSTPolicyLater DSTPolicy = "later"
+	// DSTPolicyReject the event is rejected with an error
+	DSTPolicyReject DSTPolicy = "reject"
+)
+
+// Warnings declare a list of warnings
+type Warnings []Warning
+
+// Warning declare a problem of an event, or occurrence of a recurring event, that was resolved without stopping
+// the request, like a local time in a DST change, with the local time given and the UTC instant chosen for it
+type Warning struct {
+	Code        WarningCode `json:"code"`
+	Event       int         `json:"event"`
+	Occurrence  string      `json:"occurrence,omitempty"`
+	LocalTime   string      `json:"local_time"`
+	Timezone    string      `json:"timezone"`
+	ResolvedUTC string      `json:"resolved_utc"`
+	Message     string      `json:"message"`
 }
 
+// WarningCode declare the kind of a warning
+type WarningCode string
+
+// List of warning codes
+const (
+	// WarningCodeDSTGap the local time does not exist because the clocks move forward
+	WarningCodeDSTGap WarningCode = "DST_GAP"
+	// WarningCodeDSTFold the local time happens twice because the clocks move back
+	WarningCodeDSTFold WarningCode = "DST_FOLD"
+)
+
+// Resources declare a list of resources that can be booked
+type Resources []Resource
+
+// Resource declare how many bookings a resource can host at the same time, by default one
+type Resource struct {
+	ID       string `json:"id"`
+	Capacity int    `json:"capacity"`
+}
+
+// OverlapMode declare how the boundaries of two events are compared
+type OverlapMode string
+
+// List of overlap modes supported
+const (
+	// OverlapModeHalfOpen events are [start, end), back-to-back events are not double booked, it is the default
+	OverlapModeHalfOpen OverlapMode = "half_open"
+	// OverlapModeClosed events are [start, end], sharing a boundary is double booked even for zero length events
+	OverlapModeClosed OverlapMode = "closed"
+	// OverlapModeTouchingCounts back-to-back events are double booked, zero length events follow half_open rules
+	OverlapModeTouchingCounts OverlapMode = "touching_counts"
+)
+
 // Events declare a list of events
 type Events []Event
 
-// Event declare structure for each event
+// Event declare structure for each event, an event with a recurrence rule is expanded in one event per
+// occurrence
 type Event struct {
-	ID       int    `json:"id"`
+	ID int `json:"id"`
+	// Start and End are local date times in the timezone of the event or RFC 3339 / ISO 8601 date times, which
+	// make the timezone optional when they have their UTC offset
+	Start         string   `json:"start"`
+	End           string   `json:"end"`
+	Duration      string   `json:"duration,omitempty"`
+	Timezone      string   `json:"timezone"`
+	StartTimezone string   `json:"start_timezone,omitempty"`
+	EndTimezone   string   `json:"end_timezone,omitempty"`
+	Attendees     []string `json:"attendees,omitempty"`
+	ResourceID    string   `json:"resource_id,omitempty"`
+	RRule         string   `json:"rrule,omitempty"`
+	// ExDates cancel occurrences and Overrides move them, both identify the occurrence by its original start in
+	// the timezone of the event
+	ExDates   []string  `json:"exdates,omitempty"`
+	Overrides Overrides `json:"overrides,omitempty"`
+	// OccurrenceStart is the original start of the occurrence in its timezone once the event is expanded, the
+	// value given in the request is ignored
+	OccurrenceStart string `json:"occurrence_start,omitempty"`
+	// AllDay is flagged when the event given with dates instead of date times is normalized to UTC, the value
+	// given in the request is ignored
+	AllDay bool `json:"all_day,omitempty"`
+	// Informational events, like holidays, never take part in conflicts
+	Informational bool `json:"informational,omitempty"`
+	// BufferBefore and BufferAfter are durations, like "15m", by default the ones of the options
+	BufferBefore string `json:"buffer_before,omitempty"`
+	BufferAfter  string `json:"buffer_after,omitempty"`
+	// Status and Transparency decide if the event takes part in conflicts according to the options
+	Status       EventStatus  `json:"status,omitempty"`
+	Transparency Transparency `json:"transparency,omitempty"`
+	// Priority decides which events are kept in the resolution plan, by default 1
+	Priority int `json:"priority,omitempty"`
+}
+
+// EventStatus declare if an event is going to happen, by default it is confirmed
+type EventStatus string
+
+// List of event statuses supported
+const (
+	// EventStatusConfirmed the event is going to happen
+	EventStatusConfirmed EventStatus = "confirmed"
+	// EventStatusTentative the event may happen, its conflicts are reported separately
+	EventStatusTentative EventStatus = "tentative"
+	// EventStatusCancelled the event is not going to happen
+	EventStatusCancelled EventStatus = "cancelled"
+)
+
+// Transparency declare if an event blocks time in the calendar, by default it is opaque
+type Transparency string
+
+// List of transparencies supported
+const (
+	// TransparencyOpaque the event blocks time in the calendar
+	TransparencyOpaque Transparency = "opaque"
+	// TransparencyTransparent the event is shown as free time in the calendar
+	TransparencyTransparent Transparency = "transparent"
+)
+
+// Overrides declare a list of occurrences of a recurring event moved to another time
+type Overrides []Override
+
+// Override declare the new start and end of an occurrence of a recurring event, they are given in timezone or,
+// when it is empty, in the timezone of the event
+type Override struct {
+	OccurrenceStart string `json:"occurrence_start"`
+	Start           string `json:"start"`
+	End             string `json:"end"`
+	Timezone        string `json:"timezone,omitempty"`
+}
+
+// String format the event with the fields used to identify it in the error messages
+func (e Event) String() string {
+	return fmt.Sprintf("{%d %s %s %s}", e.ID, e.Start, e.End, e.Timezone)
+}
+
+// SortOrder declare how the double booked pairs are sorted in the response
+type SortOrder string
+
+// List of sort orders supported, every pair always has the lower event ID first
+const (
+	// SortByID pairs sorted by the first event ID and then by the second one, it is the default
+	SortByID SortOrder = "id"
+	// SortByStart pairs sorted by the instant their overlap starts
+	SortByStart SortOrder = "start"
+	// SortByDuration pairs sorted from the longest overlap to the shortest one
+	SortByDuration SortOrder = "duration"
+)
+
+// DoubleBookedEvents declare a list of pairs of double-booked events
+type DoubleBookedEvents [][]int
+
+// DoubleBookedAttendees declare the attendees shared by each pair of double-booked events, in the same order
+type DoubleBookedAttendees [][]string
+
+// DoubleBookedOccurrences declare the occurrence starts of each pair of double-booked events, in the same order
+type DoubleBookedOccurrences [][]string
+
+// Conflicts declare a list of double-booked pairs with the details of their overlap
+type Conflicts []Conflict
+
+// Conflict declare the details of a pair of double-booked events, it is in the same order as the pair.
+// Occurrences has the start of the occurrence of each recurring event in its timezone, it is empty for the
+// events that do not recur and it is omitted when none of them recurs
+type Conflict struct {
+	Events         []int      `json:"events"`
+	Occurrences    []string   `json:"occurrences,omitempty"`
+	OverlapUTC     TimeWindow `json:"overlap_utc"`
+	OverlapLocal   TimeWindow `json:"overlap_local"`
+	OverlapSeconds int64      `json:"overlap_seconds"`
+	Severity       Severity   `json:"severity"`
+	Attendees      []string   `json:"attendees,omitempty"`
+}
+
+// TimeWindow declare a window of time with its start and end in the timezone given
+type TimeWindow struct {
 	Start    string `json:"start"`
 	End      string `json:"end"`
 	Timezone string `json:"timezone"`
 }
 
-// DoubleBookedEvents declare a list of pairs of double-booked events
-type DoubleBookedEvents [][]int
+// Clusters declare a list of groups of events that overlap each other
+type Clusters []Cluster
+
+// Cluster declare a connected component of the overlap graph, every event overlaps at least another member
+type Cluster struct {
+	Events    []int      `json:"events"`
+	SpanUTC   TimeWindow `json:"span_utc"`
+	SpanLocal TimeWindow `json:"span_local"`
+}
+
+// ResourceConflicts declare a list of windows where resources are booked over their capacity
+type ResourceConflicts []ResourceConflict
+
+// ResourceConflict declare a window where the bookings of a resource exceed its capacity, peak is the maximum
+// number of bookings running at the same time inside the window
+type ResourceConflict struct {
+	ResourceID  string     `json:"resource_id"`
+	Capacity    int        `json:"capacity"`
+	Peak        int        `json:"peak"`
+	WindowUTC   TimeWindow `json:"window_utc"`
+	WindowLocal TimeWindow `json:"window_local"`
+	Bookings    []int      `json:"bookings"`
+	// Occurrences are the original starts of the bookings, in the same order, when any of them recurs
+	Occurrences []string `json:"occurrences,omitempty"`
+}
+
+// BufferConflicts declare a list of pairs of events that do not overlap but leave no time between them
+type BufferConflicts []BufferConflict
+
+// BufferConflict declare a pair of events whose windows padded with their buffers overlap, the padded windows are
+// in the same order as the pair and the overlap is the one of the padded windows
+type BufferConflict struct {
+	Events         []int        `json:"events"`
+	Occurrences    []string     `json:"occurrences,omitempty"`
+	PaddedUTC      []TimeWindow `json:"padded_utc"`
+	OverlapUTC     TimeWindow   `json:"overlap_utc"`
+	OverlapLocal   TimeWindow   `json:"overlap_local"`
+	OverlapSeconds int64        `json:"overlap_seconds"`
+	Attendees      []string     `json:"attendees,omitempty"`
+}
+
+// DoubleBookedReport declare the result of the double booked detection, the pairs with a tentative event are
+// reported apart from the confirmed ones
+type DoubleBookedReport struct {
+	DoubleBookedEvents      DoubleBookedEvents      `json:"double_booked_events"`
+	DoubleBookedOccurrences DoubleBookedOccurrences `json:"double_booked_occurrences,omitempty"`
+	DoubleBookedAttendees   DoubleBookedAttendees   `json:"double_booked_attendees,omitempty"`
+	Conflicts               Conflicts               `json:"conflicts,omitempty"`
+	Clusters                Clusters                `json:"clusters,omitempty"`
+	ResourceConflicts       ResourceConflicts       `json:"resource_conflicts,omitempty"`
+	BufferConflicts         BufferConflicts         `json:"buffer_conflicts,omitempty"`
+
+	TentativeDoubleBookedEvents      DoubleBookedEvents      `json:"tentative_double_booked_events,omitempty"`
+	TentativeDoubleBookedOccurrences DoubleBookedOccurrences `json:"tentative_double_booked_occurrences,omitempty"`
+	TentativeDoubleBookedAttendees   DoubleBookedAttendees   `json:"tentative_double_booked_attendees,omitempty"`
+	TentativeConflicts               Conflicts               `json:"tentative_conflicts,omitempty"`
+	Resolution                       *ResolutionPlan         `json:"resolution,omitempty"`
+	Warnings                         Warnings                `json:"warnings,omitempty"`
+}
+
+// ResolutionPlan declare the events to decline or move so the rest of the calendar has no double bookings while
+// keeping the highest total priority, the priorities are the ones of the double booked events
+type ResolutionPlan struct {
+	KeptPriority     int              `json:"kept_priority"`
+	DeclinedPriority int              `json:"declined_priority"`
+	Steps            []ResolutionStep `json:"steps"`
+}
+
+// ResolutionStep declare an event, or an occurrence of a recurring event, to decline or move and the kept events
+// it is double booked with
+type ResolutionStep struct {
+	Action        ResolutionAction `json:"action"`
+	Event         int              `json:"event"`
+	Occurrence    string           `json:"occurrence,omitempty"`
+	Priority      int              `json:"priority"`
+	ConflictsWith []int            `json:"conflicts_with"`
+	Explanation   string           `json:"explanation"`
+}
+
+// ResolutionAction declare what to do with an event of the resolution plan
+type ResolutionAction string
+
+// List of resolution actions supported
+const (
+	// ResolutionActionDecline the event must be declined or moved to a time without conflicts
+	ResolutionActionDecline ResolutionAction = "decline"
+)
+
+// TrackAssignmentReport declare the minimum number of tracks, like rooms, needed to run the events without
+// overlaps and the track assigned to every event, or occurrence of a recurring event, sorted by start
+type TrackAssignmentReport struct {
+	Tracks      int               `json:"tracks"`
+	Assignments []TrackAssignment `json:"assignments"`
+	Warnings    Warnings          `json:"warnings,omitempty"`
+}
+
+// TrackAssignment declare the track, starting at 1, assigned to an event
+type TrackAssignment struct {
+	Track      int    `json:"track"`
+	Event      int    `json:"event"`
+	Occurrence string `json:"occurrence,omitempty"`
+}
+
+// ConcurrencyReport declare how many events run at the same time along the calendar
+type ConcurrencyReport struct {
+	Peak        int               `json:"peak"`
+	PeakWindows []TimeWindow      `json:"peak_windows"`
+	Timeline    []ConcurrencyStep `json:"timeline"`
+	Warnings    Warnings          `json:"warnings,omitempty"`
+}
+
+// ConcurrencyStep declare a window of time where the number of events running does not change
+type ConcurrencyStep struct {
+	Window TimeWindow `json:"window"`
+	Count  int        `json:"count"`
+}
+
+// FreeBusyReport declare the merged busy blocks and the free gaps between them inside the window requested
+type FreeBusyReport struct {
+	Window   TimeWindow   `json:"window"`
+	Busy     []TimeWindow `json:"busy"`
+	Free     []TimeWindow `json:"free"`
+	Warnings Warnings     `json:"warnings,omitempty"`
+}
+
+// AvailabilityReport declare if a candidate event can be booked and the events it conflicts with, the
+// conflicts always have the candidate first. The conflicts with tentative events do not make it unavailable
+type AvailabilityReport struct {
+	Available          bool              `json:"available"`
+	ConflictingEvents  []int             `json:"conflicting_events"`
+	Conflicts          Conflicts         `json:"conflicts"`
+	TentativeConflicts Conflicts         `json:"tentative_conflicts,omitempty"`
+	ResourceConflicts  ResourceConflicts `json:"resource_conflicts,omitempty"`
+	Warnings           Warnings          `json:"warnings,omitempty"`
+}
+
+// CalendarDiffReport declare the conflicts introduced, resolved and unchanged between two versions of a
+// calendar and the events added, removed and modified. The resolved conflicts have the details before the changes
+type CalendarDiffReport struct {
+	Introduced     Conflicts `json:"introduced"`
+	Resolved       Conflicts `json:"resolved"`
+	Unchanged      Conflicts `json:"unchanged"`
+	AddedEvents    []int     `json:"added_events"`
+	RemovedEvents  []int     `json:"removed_events"`
+	ModifiedEvents []int     `json:"modified_events"`
+	Warnings       Warnings  `json:"warnings,omitempty"`
+}
+
+// RescheduleReport declare the new slots proposed to the events to move out of their conflicts
+type RescheduleReport struct {
+	Proposals []RescheduleProposal `json:"proposals"`
+	Warnings  Warnings             `json:"warnings,omitempty"`
+}
+
+// RescheduleProposal declare the event, or occurrence of a recurring event, to move, the events it is double
+// booked with and the alternatives ranked from the closest to its current start
+type RescheduleProposal struct {
+	Event         int              `json:"event"`
+	Occurrence    string           `json:"occurrence,omitempty"`
+	Priority      int              `json:"priority"`
+	ConflictsWith []int            `json:"conflicts_with"`
+	Alternatives  []RescheduleSlot `json:"alternatives"`
+}
+
+// RescheduleSlot declare a new slot for an event in UTC and in the timezone of the options and how many
+// minutes it moves the event, negative when it is earlier
+type RescheduleSlot struct {
+	Rank         int        `json:"rank"`
+	SlotUTC      TimeWindow `json:"slot_utc"`
+	SlotLocal    TimeWindow `json:"slot_local"`
+	ShiftMinutes int64      `json:"shift_minutes"`
+}
+
+// MeetingSlotsReport declare the first slots where every participant is free
+type MeetingSlotsReport struct {
+	Slots    []TimeWindow `json:"slots"`
+	Warnings Warnings     `json:"warnings,omitempty"`
+}
 
 // ResponseBody struct for response body
 type ResponseBody struct {
-	DoubleBookedEvents DoubleBookedEvents `json:"double_booked_events"`
+	DoubleBookedReport
 }
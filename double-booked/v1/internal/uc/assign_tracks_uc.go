@@ -0,0 +1,105 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"container/heap"
+	"time"
+)
+
+// AssignTracksUC declaration of use case struct used in this file
+type AssignTracksUC struct{}
+
+// Handle find the minimum number of tracks needed to run the events given without overlaps and assign every
+// event to one of them. The events are sorted by start and every one takes the track that became free first
+// or opens a new one when every track is still busy, keeping a min-heap of the tracks ordered by the end of
+// their last event, so it runs in O(n log n). Only the events that take part in conflicts are assigned
+func (uc *AssignTracksUC) Handle(
+	events models.Events,
+	options models.Options,
+) (models.TrackAssignmentReport, error) {
+	mode, err := resolveOverlapMode(options)
+	if err != nil {
+		return models.TrackAssignmentReport{}, err
+	}
+
+	rules, err := resolveParticipation(options)
+	if err != nil {
+		return models.TrackAssignmentReport{}, err
+	}
+
+	parsed, err := parseIntervals(events)
+	if err != nil {
+		return models.TrackAssignmentReport{}, err
+	}
+
+	intervals := participatingIntervals(parsed, rules)
+	sortIntervals(intervals)
+
+	report := models.TrackAssignmentReport{
+		Assignments: make([]models.TrackAssignment, 0, len(intervals)),
+	}
+
+	tracks := &trackHeap{}
+
+	for _, current := range intervals {
+		track := report.Tracks + 1
+
+		if tracks.Len() > 0 && hasEnded(interval{end: (*tracks)[0].end}, current.start, mode) {
+			track = heap.Pop(tracks).(trackEnd).track
+		} else {
+			report.Tracks++
+		}
+
+		heap.Push(tracks, trackEnd{track: track, end: current.end})
+
+		report.Assignments = append(report.Assignments, models.TrackAssignment{
+			Track:      track,
+			Event:      current.event.ID,
+			Occurrence: current.event.OccurrenceStart,
+		})
+	}
+
+	return report, nil
+}
+
+// trackEnd track and the end of the last event assigned to it
+type trackEnd struct {
+	track int
+	end   time.Time
+}
+
+// trackHeap min-heap of tracks ordered by the end of their last event, ties by the lowest track
+type trackHeap []trackEnd
+
+// Len implements heap.Interface
+func (h trackHeap) Len() int { return len(h) }
+
+// Less implements heap.Interface
+func (h trackHeap) Less(i, j int) bool {
+	if !h[i].end.Equal(h[j].end) {
+		return h[i].end.Before(h[j].end)
+	}
+
+	return h[i].track < h[j].track
+}
+
+// Swap implements heap.Interface
+func (h trackHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push implements heap.Interface
+func (h *trackHeap) Push(x interface{}) { *h = append(*h, x.(trackEnd)) }
+
+// Pop implements heap.Interface
+func (h *trackHeap) Pop() interface{} {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return last
+}
+
+// NewAssignTracksUC initialize this use case
+func NewAssignTracksUC() *AssignTracksUC {
+	return &AssignTracksUC{}
+}
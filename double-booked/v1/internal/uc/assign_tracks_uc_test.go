@@ -0,0 +1,149 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestAssignTracksUC_Handle test for this method
+func TestAssignTracksUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		events  models.Events
+		options models.Options
+	}
+
+	conference := models.Events{
+		models.Event{ID: 1, Start: "2023-02-02 09:00", End: "2023-02-02 10:00", Timezone: "UTC"},
+		models.Event{ID: 2, Start: "2023-02-02 09:30", End: "2023-02-02 11:00", Timezone: "UTC"},
+		models.Event{ID: 3, Start: "2023-02-02 10:00", End: "2023-02-02 11:00", Timezone: "UTC"},
+		models.Event{ID: 4, Start: "2023-02-02 10:30", End: "2023-02-02 12:00", Timezone: "UTC"},
+		models.Event{ID: 5, Start: "2023-02-02 11:00", End: "2023-02-02 12:00", Timezone: "UTC"},
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.TrackAssignmentReport
+		wantErr bool
+	}{
+		{
+			name: "Success reusing the tracks of the events that ended",
+			args: args{
+				events: conference,
+			},
+			want: models.TrackAssignmentReport{
+				Tracks: 3,
+				Assignments: []models.TrackAssignment{
+					{Track: 1, Event: 1},
+					{Track: 2, Event: 2},
+					{Track: 1, Event: 3},
+					{Track: 3, Event: 4},
+					{Track: 1, Event: 5},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with back-to-back events in different tracks in closed mode",
+			args: args{
+				events:  conference,
+				options: models.Options{OverlapMode: models.OverlapModeClosed},
+			},
+			want: models.TrackAssignmentReport{
+				Tracks: 4,
+				Assignments: []models.TrackAssignment{
+					{Track: 1, Event: 1},
+					{Track: 2, Event: 2},
+					{Track: 3, Event: 3},
+					{Track: 1, Event: 4},
+					{Track: 4, Event: 5},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success skipping the events that do not take part in conflicts",
+			args: args{
+				events: models.Events{
+					models.Event{ID: 1, Start: "2023-02-02 09:00", End: "2023-02-02 10:00", Timezone: "UTC"},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 09:00",
+						End:      "2023-02-02 10:00",
+						Timezone: "UTC",
+						Status:   models.EventStatusCancelled,
+					},
+				},
+			},
+			want: models.TrackAssignmentReport{
+				Tracks:      1,
+				Assignments: []models.TrackAssignment{{Track: 1, Event: 1}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without events",
+			args: args{
+				events: models.Events{},
+			},
+			want:    models.TrackAssignmentReport{Assignments: []models.TrackAssignment{}},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid overlap mode",
+			args: args{
+				events:  conference,
+				options: models.Options{OverlapMode: "WRONG"},
+			},
+			want:    models.TrackAssignmentReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := &AssignTracksUC{}
+			got, err := uc.Handle(tt.args.events, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewAssignTracksUC test for this method
+func TestNewAssignTracksUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *AssignTracksUC
+	}{
+		{
+			name: "Success",
+			want: NewAssignTracksUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewAssignTracksUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewAssignTracksUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
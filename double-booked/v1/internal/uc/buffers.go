@@ -0,0 +1,134 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"time"
+)
+
+// parseBuffer parse a buffer duration, an empty one is zero
+func parseBuffer(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	buffer, err := time.ParseDuration(value)
+	if err != nil || buffer < 0 {
+		return 0, fmt.Errorf("invalid buffer %q", value)
+	}
+
+	return buffer, nil
+}
+
+// eventBuffers parse the buffers of the event given, the missing ones take the defaults given
+func eventBuffers(event models.Event, defaultBefore, defaultAfter time.Duration) (time.Duration, time.Duration, error) {
+	before, after := defaultBefore, defaultAfter
+
+	var err error
+
+	if event.BufferBefore != "" {
+		if before, err = parseBuffer(event.BufferBefore); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if event.BufferAfter != "" {
+		if after, err = parseBuffer(event.BufferAfter); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return before, after, nil
+}
+
+// padded copy of the interval with its start and end moved by its buffers
+func (i interval) padded(before, after time.Duration) interval {
+	i.start = i.start.Add(-before)
+	i.end = i.end.Add(after)
+	i.bufferBefore = before
+	i.bufferAfter = after
+
+	return i
+}
+
+// unpadded copy of the interval with its original start and end
+func (i interval) unpadded() interval {
+	i.start = i.start.Add(i.bufferBefore)
+	i.end = i.end.Add(-i.bufferAfter)
+	i.bufferBefore = 0
+	i.bufferAfter = 0
+
+	return i
+}
+
+// findBufferConflicts find the pairs of intervals that are not double booked in the overlap mode given, or
+// whose overlap is tolerated, but whose windows padded with their buffers overlap, the padded windows are
+// [start, end)
+func findBufferConflicts(
+	intervals []interval,
+	defaultBefore, defaultAfter time.Duration,
+	mode models.OverlapMode,
+	allowed tolerance,
+) ([]conflict, error) {
+	padded := make([]interval, 0, len(intervals))
+	buffered := false
+
+	for _, i := range intervals {
+		before, after, err := eventBuffers(i.event, defaultBefore, defaultAfter)
+		if err != nil {
+			return nil, &models.EventError{
+				Code:       models.CodeParseEventError,
+				ID:         models.IDDoubleBookedError,
+				Message:    fmt.Sprintf("Error parsing buffers of event %v", i.event),
+				StatusCode: models.CodeStatusHTTPBusinessError,
+			}
+		}
+
+		buffered = buffered || before > 0 || after > 0
+		padded = append(padded, i.padded(before, after))
+	}
+
+	// Without buffers the padded windows are the events, so every collision is already a double booking
+	if !buffered {
+		return nil, nil
+	}
+
+	sortIntervals(padded)
+
+	var conflicts []conflict
+
+	for _, c := range sweepConflicts(padded, models.OverlapModeHalfOpen) {
+		first, second := c.first.unpadded(), c.second.unpadded()
+		if overlaps(first, second, mode) && !allowed.tolerated(newConflict(first, second)) {
+			continue
+		}
+
+		conflicts = append(conflicts, c)
+	}
+
+	return conflicts, nil
+}
+
+// toBufferConflicts convert the conflicts of padded intervals in the list returned in the response, the local
+// overlap window is shown in the location given
+func toBufferConflicts(conflicts []conflict, location *time.Location) models.BufferConflicts {
+	bufferConflicts := make(models.BufferConflicts, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		bufferConflicts = append(bufferConflicts, models.BufferConflict{
+			Events:      []int{c.first.event.ID, c.second.event.ID},
+			Occurrences: c.occurrences(),
+			PaddedUTC: []models.TimeWindow{
+				newTimeWindow(c.first.start, c.first.end, time.UTC),
+				newTimeWindow(c.second.start, c.second.end, time.UTC),
+			},
+			OverlapUTC:     newTimeWindow(c.overlapStart(), c.overlapEnd(), time.UTC),
+			OverlapLocal:   newTimeWindow(c.overlapStart(), c.overlapEnd(), location),
+			OverlapSeconds: int64(c.overlapDuration() / time.Second),
+			Attendees:      c.attendees,
+		})
+	}
+
+	return bufferConflicts
+}
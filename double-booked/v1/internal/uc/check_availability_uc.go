@@ -0,0 +1,137 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"sort"
+)
+
+// CheckAvailabilityUC declaration of use case struct used in this file
+type CheckAvailabilityUC struct{}
+
+// Handle check if the candidate given, already in UTC, can be booked in the calendar given. A recurring
+// candidate comes as one event per occurrence. Every occurrence is only compared with the events of the
+// calendar, so it runs in O(n) for each occurrence instead of finding every pair of the calendar.
+// The events with the same ID as the candidate are the event being moved and are not compared
+func (uc *CheckAvailabilityUC) Handle(
+	events models.Events,
+	candidate models.Events,
+	options models.Options,
+) (models.AvailabilityReport, error) {
+	rules, err := resolveConflictRules(options)
+	if err != nil {
+		return models.AvailabilityReport{}, err
+	}
+
+	order, err := resolveSortOrder(options)
+	if err != nil {
+		return models.AvailabilityReport{}, err
+	}
+
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.AvailabilityReport{}, err
+	}
+
+	capacities, err := resolveCapacities(options)
+	if err != nil {
+		return models.AvailabilityReport{}, err
+	}
+
+	parsedEvents, err := parseIntervals(events)
+	if err != nil {
+		return models.AvailabilityReport{}, err
+	}
+
+	parsedCandidate, err := parseIntervals(candidate)
+	if err != nil {
+		return models.AvailabilityReport{}, err
+	}
+
+	booked := rules.participating(parsedEvents)
+	occurrences := rules.participating(parsedCandidate)
+
+	var (
+		conflicts         []conflict
+		resourceConflicts []resourceConflict
+	)
+
+	for _, occurrence := range occurrences {
+		var bookings []interval
+
+		for _, current := range booked {
+			if current.event.ID == occurrence.event.ID || !overlaps(occurrence, current, rules.mode) {
+				continue
+			}
+
+			// Bookings of the same resource are also checked against the resource capacity
+			if sameResource(occurrence, current) {
+				bookings = append(bookings, current)
+			}
+
+			attendees, ok := doubleBookedAttendees(occurrence, current)
+			if !ok {
+				continue
+			}
+
+			conflicts = append(conflicts, conflict{first: occurrence, second: current, attendees: attendees})
+		}
+
+		if len(bookings) > 0 {
+			resourceConflicts = append(resourceConflicts, candidateOverCapacity(occurrence, bookings, capacities)...)
+		}
+	}
+
+	conflicts, tentativeConflicts := splitTentative(rules.grade(conflicts))
+	sortConflicts(conflicts, order)
+	sortConflicts(tentativeConflicts, order)
+
+	report := models.AvailabilityReport{
+		Available:         len(conflicts) == 0 && len(resourceConflicts) == 0,
+		ConflictingEvents: conflictingEvents(conflicts),
+		Conflicts:         toConflicts(conflicts, location),
+	}
+
+	if len(tentativeConflicts) > 0 {
+		report.TentativeConflicts = toConflicts(tentativeConflicts, location)
+	}
+
+	if len(resourceConflicts) > 0 {
+		report.ResourceConflicts = toResourceConflicts(resourceConflicts, location)
+	}
+
+	return report, nil
+}
+
+// candidateOverCapacity find the windows where the candidate given exceeds the capacity of its resource together
+// with the bookings of the resource that overlap it
+func candidateOverCapacity(candidate interval, bookings []interval, capacities map[string]int) []resourceConflict {
+	capacity := resourceCapacity(capacities, candidate.event.ResourceID)
+
+	var overCapacity []resourceConflict
+
+	// Only the windows where the candidate is running are caused by booking it
+	for _, window := range overCapacityWindows(candidate.event.ResourceID, capacity, append(bookings, candidate)) {
+		if i := sort.SearchInts(window.bookings, candidate.event.ID); i < len(window.bookings) &&
+			window.bookings[i] == candidate.event.ID {
+			overCapacity = append(overCapacity, window)
+		}
+	}
+
+	return overCapacity
+}
+
+// conflictingEvents IDs of the events of the calendar in conflict with the candidate, sorted and without duplicates
+func conflictingEvents(conflicts []conflict) []int {
+	involved := map[int]bool{}
+	for _, c := range conflicts {
+		involved[c.second.event.ID] = true
+	}
+
+	return sortedBookings(involved)
+}
+
+// NewCheckAvailabilityUC initialize this use case
+func NewCheckAvailabilityUC() *CheckAvailabilityUC {
+	return &CheckAvailabilityUC{}
+}
@@ -0,0 +1,324 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestCheckAvailabilityUC_Handle test for this method
+func TestCheckAvailabilityUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		events    models.Events
+		candidate models.Events
+		options   models.Options
+	}
+
+	calendar := models.Events{
+		models.Event{
+			ID:       1,
+			Start:    "2023-02-02 12:00",
+			End:      "2023-02-02 13:00",
+			Timezone: "UTC",
+		},
+		models.Event{
+			ID:       2,
+			Start:    "2023-02-02 12:30",
+			End:      "2023-02-02 14:00",
+			Timezone: "UTC",
+		},
+		models.Event{
+			ID:         3,
+			Start:      "2023-02-02 15:00",
+			End:        "2023-02-02 16:00",
+			Timezone:   "UTC",
+			ResourceID: "room-a",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.AvailabilityReport
+		wantErr bool
+	}{
+		{
+			name: "Available between the events",
+			args: args{
+				events: calendar,
+				candidate: models.Events{
+					models.Event{ID: 10, Start: "2023-02-02 14:00", End: "2023-02-02 15:00", Timezone: "UTC"},
+				},
+			},
+			want: models.AvailabilityReport{
+				Available:         true,
+				ConflictingEvents: []int{},
+				Conflicts:         models.Conflicts{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Conflicts with the events it overlaps",
+			args: args{
+				events: calendar,
+				candidate: models.Events{
+					models.Event{ID: 10, Start: "2023-02-02 12:45", End: "2023-02-02 13:15", Timezone: "UTC"},
+				},
+			},
+			want: models.AvailabilityReport{
+				Available:         false,
+				ConflictingEvents: []int{1, 2},
+				Conflicts: models.Conflicts{
+					{
+						Events: []int{10, 1},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 12:45",
+							End:      "2023-02-02 13:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 12:45",
+							End:      "2023-02-02 13:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 900,
+						Severity:       models.SeverityWarning,
+					},
+					{
+						Events: []int{10, 2},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 12:45",
+							End:      "2023-02-02 13:15",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 12:45",
+							End:      "2023-02-02 13:15",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 1800,
+						Severity:       models.SeverityCritical,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Moving an event does not conflict with itself",
+			args: args{
+				events: calendar,
+				candidate: models.Events{
+					models.Event{ID: 2, Start: "2023-02-02 13:00", End: "2023-02-02 14:30", Timezone: "UTC"},
+				},
+			},
+			want: models.AvailabilityReport{
+				Available:         true,
+				ConflictingEvents: []int{},
+				Conflicts:         models.Conflicts{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Resource over capacity",
+			args: args{
+				events: calendar,
+				candidate: models.Events{
+					models.Event{
+						ID:         10,
+						Start:      "2023-02-02 15:30",
+						End:        "2023-02-02 16:30",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+					},
+				},
+			},
+			want: models.AvailabilityReport{
+				Available:         false,
+				ConflictingEvents: []int{},
+				Conflicts:         models.Conflicts{},
+				ResourceConflicts: models.ResourceConflicts{
+					{
+						ResourceID: "room-a",
+						Capacity:   1,
+						Peak:       2,
+						WindowUTC: models.TimeWindow{
+							Start:    "2023-02-02 15:30",
+							End:      "2023-02-02 16:00",
+							Timezone: "UTC",
+						},
+						WindowLocal: models.TimeWindow{
+							Start:    "2023-02-02 15:30",
+							End:      "2023-02-02 16:00",
+							Timezone: "UTC",
+						},
+						Bookings: []int{3, 10},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Resource with capacity left",
+			args: args{
+				events: calendar,
+				candidate: models.Events{
+					models.Event{
+						ID:         10,
+						Start:      "2023-02-02 15:30",
+						End:        "2023-02-02 16:30",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+					},
+				},
+				options: models.Options{Resources: models.Resources{{ID: "room-a", Capacity: 2}}},
+			},
+			want: models.AvailabilityReport{
+				Available:         true,
+				ConflictingEvents: []int{},
+				Conflicts:         models.Conflicts{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Conflicts with a booking of another resource",
+			args: args{
+				events: calendar,
+				candidate: models.Events{
+					models.Event{
+						ID:         10,
+						Start:      "2023-02-02 15:30",
+						End:        "2023-02-02 16:30",
+						Timezone:   "UTC",
+						ResourceID: "room-b",
+					},
+				},
+			},
+			want: models.AvailabilityReport{
+				Available:         false,
+				ConflictingEvents: []int{3},
+				Conflicts: models.Conflicts{
+					{
+						Events: []int{10, 3},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 15:30",
+							End:      "2023-02-02 16:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 15:30",
+							End:      "2023-02-02 16:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 1800,
+						Severity:       models.SeverityWarning,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Conflicts with a booking of the same resource with a shared attendee",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:         1,
+						Start:      "2023-02-02 13:00",
+						End:        "2023-02-02 14:00",
+						Timezone:   "UTC",
+						ResourceID: "room",
+						Attendees:  []string{"alice"},
+					},
+				},
+				candidate: models.Events{
+					models.Event{
+						ID:         10,
+						Start:      "2023-02-02 13:00",
+						End:        "2023-02-02 14:00",
+						Timezone:   "UTC",
+						ResourceID: "room",
+						Attendees:  []string{"alice"},
+					},
+				},
+				options: models.Options{Resources: models.Resources{{ID: "room", Capacity: 2}}},
+			},
+			want: models.AvailabilityReport{
+				Available:         false,
+				ConflictingEvents: []int{1},
+				Conflicts: models.Conflicts{
+					{
+						Events: []int{10, 1},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 13:00",
+							End:      "2023-02-02 14:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 13:00",
+							End:      "2023-02-02 14:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 3600,
+						Severity:       models.SeverityCritical,
+						Attendees:      []string{"alice"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid overlap mode",
+			args: args{
+				events:  calendar,
+				options: models.Options{OverlapMode: "WRONG"},
+			},
+			want:    models.AvailabilityReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := &CheckAvailabilityUC{}
+			got, err := uc.Handle(tt.args.events, tt.args.candidate, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewCheckAvailabilityUC test for this method
+func TestNewCheckAvailabilityUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *CheckAvailabilityUC
+	}{
+		{
+			name: "Success",
+			want: NewCheckAvailabilityUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewCheckAvailabilityUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCheckAvailabilityUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"sort"
+	"time"
+)
+
+// cluster connected component of the overlap graph with the combined span of its members
+type cluster struct {
+	ids   []int
+	start time.Time
+	end   time.Time
+}
+
+// unionFind disjoint sets of node indexes used to group the double booked intervals
+type unionFind map[int]int
+
+// find return the representative node of the set, compressing the path on the way
+func (u unionFind) find(node int) int {
+	parent, ok := u[node]
+	if !ok {
+		u[node] = node
+
+		return node
+	}
+
+	if parent == node {
+		return node
+	}
+
+	root := u.find(parent)
+	u[node] = root
+
+	return root
+}
+
+// union join the sets of both nodes
+func (u unionFind) union(a, b int) {
+	rootA, rootB := u.find(a), u.find(b)
+	if rootA == rootB {
+		return
+	}
+
+	if rootB < rootA {
+		rootA, rootB = rootB, rootA
+	}
+
+	u[rootB] = rootA
+}
+
+// buildClusters group the conflicts in the connected components of the overlap graph, every occurrence of a
+// recurring event is a different node so the occurrences of a series on different days are not joined. The
+// clusters are sorted by the start of their span and then by their lowest event ID
+func buildClusters(conflicts []conflict) []cluster {
+	components := conflictComponents(conflicts)
+	clusters := make([]cluster, 0, len(components))
+
+	for _, members := range components {
+		current := cluster{start: members[0].start, end: members[0].end}
+		ids := map[int]bool{}
+
+		for _, member := range members {
+			ids[member.event.ID] = true
+
+			if member.start.Before(current.start) {
+				current.start = member.start
+			}
+
+			if member.end.After(current.end) {
+				current.end = member.end
+			}
+		}
+
+		current.ids = sortedBookings(ids)
+		clusters = append(clusters, current)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if !clusters[i].start.Equal(clusters[j].start) {
+			return clusters[i].start.Before(clusters[j].start)
+		}
+
+		return clusters[i].ids[0] < clusters[j].ids[0]
+	})
+
+	return clusters
+}
+
+// toClusters convert the clusters in the list returned in the response, the local span is shown in the
+// location given
+func toClusters(clusters []cluster, location *time.Location) models.Clusters {
+	details := make(models.Clusters, 0, len(clusters))
+
+	for _, c := range clusters {
+		details = append(details, models.Cluster{
+			Events:    c.ids,
+			SpanUTC:   newTimeWindow(c.start, c.end, time.UTC),
+			SpanLocal: newTimeWindow(c.start, c.end, location),
+		})
+	}
+
+	return details
+}
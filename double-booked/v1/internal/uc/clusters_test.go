@@ -0,0 +1,124 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Test_buildClusters test for this method
+func Test_buildClusters(t *testing.T) {
+	t.Parallel()
+
+	first := newTestInterval(1, "2023-02-02 13:00", "2023-02-02 14:00")
+	second := newTestInterval(2, "2023-02-02 13:30", "2023-02-02 15:00")
+	third := newTestInterval(3, "2023-02-02 14:30", "2023-02-02 16:00")
+	fourth := newTestInterval(4, "2023-02-02 09:00", "2023-02-02 10:00")
+	fifth := newTestInterval(5, "2023-02-02 09:30", "2023-02-02 09:45")
+
+	// Occurrences of the same series on different days share the ID but not the cluster
+	monday := newTestInterval(6, "2023-02-06 10:00", "2023-02-06 11:00")
+	monday.event.OccurrenceStart = "2023-02-06 10:00"
+	wednesday := newTestInterval(6, "2023-02-08 10:00", "2023-02-08 11:00")
+	wednesday.event.OccurrenceStart = "2023-02-08 10:00"
+	onMonday := newTestInterval(7, "2023-02-06 10:30", "2023-02-06 11:30")
+	onWednesday := newTestInterval(8, "2023-02-08 10:30", "2023-02-08 11:30")
+
+	tests := []struct {
+		name      string
+		conflicts []conflict
+		want      models.Clusters
+	}{
+		{
+			name:      "Without conflicts",
+			conflicts: nil,
+			want:      models.Clusters{},
+		},
+		{
+			name: "Chained and independent clusters",
+			conflicts: []conflict{
+				newConflict(first, second),
+				newConflict(third, second),
+				newConflict(fourth, fifth),
+			},
+			want: models.Clusters{
+				{
+					Events: []int{4, 5},
+					SpanUTC: models.TimeWindow{
+						Start:    "2023-02-02 09:00",
+						End:      "2023-02-02 10:00",
+						Timezone: "UTC",
+					},
+					SpanLocal: models.TimeWindow{
+						Start:    "2023-02-02 04:00",
+						End:      "2023-02-02 05:00",
+						Timezone: "America/Bogota",
+					},
+				},
+				{
+					Events: []int{1, 2, 3},
+					SpanUTC: models.TimeWindow{
+						Start:    "2023-02-02 13:00",
+						End:      "2023-02-02 16:00",
+						Timezone: "UTC",
+					},
+					SpanLocal: models.TimeWindow{
+						Start:    "2023-02-02 08:00",
+						End:      "2023-02-02 11:00",
+						Timezone: "America/Bogota",
+					},
+				},
+			},
+		},
+		{
+			name: "Occurrences of a recurring event in different clusters",
+			conflicts: []conflict{
+				newConflict(monday, onMonday),
+				newConflict(wednesday, onWednesday),
+			},
+			want: models.Clusters{
+				{
+					Events: []int{6, 7},
+					SpanUTC: models.TimeWindow{
+						Start:    "2023-02-06 10:00",
+						End:      "2023-02-06 11:30",
+						Timezone: "UTC",
+					},
+					SpanLocal: models.TimeWindow{
+						Start:    "2023-02-06 05:00",
+						End:      "2023-02-06 06:30",
+						Timezone: "America/Bogota",
+					},
+				},
+				{
+					Events: []int{6, 8},
+					SpanUTC: models.TimeWindow{
+						Start:    "2023-02-08 10:00",
+						End:      "2023-02-08 11:30",
+						Timezone: "UTC",
+					},
+					SpanLocal: models.TimeWindow{
+						Start:    "2023-02-08 05:00",
+						End:      "2023-02-08 06:30",
+						Timezone: "America/Bogota",
+					},
+				},
+			},
+		},
+	}
+
+	location, _ := time.LoadLocation("America/Bogota")
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := toClusters(buildClusters(tt.conflicts), location); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildClusters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"sort"
+	"time"
+)
+
+// conflict pair of double booked intervals, first always has the lower event ID
+type conflict struct {
+	first     interval
+	second    interval
+	attendees []string
+	severity  models.Severity
+}
+
+// conflictRules settings of the request that decide which pairs are double booked and how serious they are
+type conflictRules struct {
+	mode          models.OverlapMode
+	allowed       tolerance
+	thresholds    models.SeverityThresholds
+	minSeverity   models.Severity
+	participation participation
+}
+
+// resolveConflictRules validate the options that decide which pairs are double booked
+func resolveConflictRules(options models.Options) (conflictRules, error) {
+	mode, err := resolveOverlapMode(options)
+	if err != nil {
+		return conflictRules{}, err
+	}
+
+	allowed, err := resolveTolerance(options)
+	if err != nil {
+		return conflictRules{}, err
+	}
+
+	thresholds, err := resolveSeverityThresholds(options)
+	if err != nil {
+		return conflictRules{}, err
+	}
+
+	minSeverity, err := resolveMinSeverity(options)
+	if err != nil {
+		return conflictRules{}, err
+	}
+
+	participation, err := resolveParticipation(options)
+	if err != nil {
+		return conflictRules{}, err
+	}
+
+	return conflictRules{
+		mode:          mode,
+		allowed:       allowed,
+		thresholds:    thresholds,
+		minSeverity:   minSeverity,
+		participation: participation,
+	}, nil
+}
+
+// participating keep the intervals that take part in conflicts according to the rules
+func (r conflictRules) participating(intervals []interval) []interval {
+	return participatingIntervals(intervals, r.participation)
+}
+
+// grade set the severity of the conflicts given and keep the ones reported according to the rules
+func (r conflictRules) grade(conflicts []conflict) []conflict {
+	return gradeConflicts(conflicts, r.allowed, r.thresholds, r.minSeverity)
+}
+
+// tentative check if any event of the conflict is tentative
+func (c conflict) tentative() bool {
+	return eventStatus(c.first.event) == models.EventStatusTentative ||
+		eventStatus(c.second.event) == models.EventStatusTentative
+}
+
+// splitTentative split the conflicts in the confirmed ones and the ones with a tentative event
+func splitTentative(conflicts []conflict) ([]conflict, []conflict) {
+	var confirmed, tentative []conflict
+
+	for _, c := range conflicts {
+		if c.tentative() {
+			tentative = append(tentative, c)
+		} else {
+			confirmed = append(confirmed, c)
+		}
+	}
+
+	return confirmed, tentative
+}
+
+// newConflict build a conflict in its canonical order
+func newConflict(a, b interval) conflict {
+	if b.event.ID < a.event.ID {
+		a, b = b, a
+	}
+
+	return conflict{first: a, second: b}
+}
+
+// overlapStart instant where both events start to run at the same time
+func (c conflict) overlapStart() time.Time {
+	if c.first.start.After(c.second.start) {
+		return c.first.start
+	}
+
+	return c.second.start
+}
+
+// overlapEnd instant where one of the events ends
+func (c conflict) overlapEnd() time.Time {
+	if c.first.end.Before(c.second.end) {
+		return c.first.end
+	}
+
+	return c.second.end
+}
+
+// overlapDuration time both events run at the same time, it is zero for events that only touch each other
+func (c conflict) overlapDuration() time.Duration {
+	if duration := c.overlapEnd().Sub(c.overlapStart()); duration > 0 {
+		return duration
+	}
+
+	return 0
+}
+
+// lessByID compare two conflicts by the first event ID and then by the second one, the occurrences of the same
+// recurring events are compared by their start
+func (c conflict) lessByID(other conflict) bool {
+	if c.first.event.ID != other.first.event.ID {
+		return c.first.event.ID < other.first.event.ID
+	}
+
+	if c.second.event.ID != other.second.event.ID {
+		return c.second.event.ID < other.second.event.ID
+	}
+
+	if !c.first.start.Equal(other.first.start) {
+		return c.first.start.Before(other.first.start)
+	}
+
+	return c.second.start.Before(other.second.start)
+}
+
+// occurrences start of the occurrence of each event in the timezone of its event, it is empty for the events
+// that do not recur and nil when none of them recurs
+func (c conflict) occurrences() []string {
+	if c.first.event.OccurrenceStart == "" && c.second.event.OccurrenceStart == "" {
+		return nil
+	}
+
+	return []string{c.first.event.OccurrenceStart, c.second.event.OccurrenceStart}
+}
+
+// sortConflicts sort the conflicts in the order given, ties are always broken by ID to keep the result stable
+func sortConflicts(conflicts []conflict, order models.SortOrder) {
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		switch order {
+		case models.SortByStart:
+			if !conflicts[i].overlapStart().Equal(conflicts[j].overlapStart()) {
+				return conflicts[i].overlapStart().Before(conflicts[j].overlapStart())
+			}
+		case models.SortByDuration:
+			if conflicts[i].overlapDuration() != conflicts[j].overlapDuration() {
+				return conflicts[i].overlapDuration() > conflicts[j].overlapDuration()
+			}
+		}
+
+		return conflicts[i].lessByID(conflicts[j])
+	})
+}
+
+// toDoubleBookedEvents convert the conflicts in the list of pairs of IDs returned in the response
+func toDoubleBookedEvents(conflicts []conflict) models.DoubleBookedEvents {
+	doubleBookedEvents := make(models.DoubleBookedEvents, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		doubleBookedEvents = append(doubleBookedEvents, []int{c.first.event.ID, c.second.event.ID})
+	}
+
+	return doubleBookedEvents
+}
+
+// toDoubleBookedOccurrences convert the conflicts in the list of occurrence starts returned in the response, in
+// the same order as the pairs, so the pairs of the occurrences of a recurring event can be told apart. It is
+// nil when no pair has a recurring event, so the calendars without them keep the same response
+func toDoubleBookedOccurrences(conflicts []conflict) models.DoubleBookedOccurrences {
+	withOccurrences := false
+
+	occurrences := make(models.DoubleBookedOccurrences, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		starts := c.occurrences()
+		if starts == nil {
+			starts = []string{}
+		}
+
+		withOccurrences = withOccurrences || len(starts) > 0
+
+		occurrences = append(occurrences, starts)
+	}
+
+	if !withOccurrences {
+		return nil
+	}
+
+	return occurrences
+}
+
+// toDoubleBookedAttendees convert the conflicts in the list of shared attendees returned in the response, in
+// the same order as the pairs. It is nil when no pair has attendees, so the calendars without them keep the
+// same response
+func toDoubleBookedAttendees(conflicts []conflict) models.DoubleBookedAttendees {
+	withAttendees := false
+
+	attendees := make(models.DoubleBookedAttendees, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		shared := append([]string{}, c.attendees...)
+		withAttendees = withAttendees || len(shared) > 0
+
+		attendees = append(attendees, shared)
+	}
+
+	if !withAttendees {
+		return nil
+	}
+
+	return attendees
+}
+
+// toConflicts convert the conflicts in the detailed list returned in the response, the local
+// overlap window is shown in the location given
+func toConflicts(conflicts []conflict, location *time.Location) models.Conflicts {
+	details := make(models.Conflicts, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		details = append(details, models.Conflict{
+			Events:         []int{c.first.event.ID, c.second.event.ID},
+			Occurrences:    c.occurrences(),
+			OverlapUTC:     newTimeWindow(c.overlapStart(), c.overlapEnd(), time.UTC),
+			OverlapLocal:   newTimeWindow(c.overlapStart(), c.overlapEnd(), location),
+			OverlapSeconds: int64(c.overlapDuration() / time.Second),
+			Severity:       c.severity,
+			Attendees:      c.attendees,
+		})
+	}
+
+	return details
+}
@@ -0,0 +1,163 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// DiffCalendarsUC declaration of use case struct used in this file
+type DiffCalendarsUC struct{}
+
+// Handle find the double booked pairs of both versions of the calendar given, already in UTC, and compare them.
+// The conflicts are matched by the IDs of their events and their occurrences, so a pair that is still double
+// booked after one of its events is moved is unchanged. The events are matched by ID to find the ones added,
+// removed or modified
+func (uc *DiffCalendarsUC) Handle(
+	before models.Events,
+	after models.Events,
+	options models.Options,
+) (models.CalendarDiffReport, error) {
+	rules, err := resolveConflictRules(options)
+	if err != nil {
+		return models.CalendarDiffReport{}, err
+	}
+
+	order, err := resolveSortOrder(options)
+	if err != nil {
+		return models.CalendarDiffReport{}, err
+	}
+
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.CalendarDiffReport{}, err
+	}
+
+	beforeConflicts, err := rules.calendarConflicts(before)
+	if err != nil {
+		return models.CalendarDiffReport{}, err
+	}
+
+	afterConflicts, err := rules.calendarConflicts(after)
+	if err != nil {
+		return models.CalendarDiffReport{}, err
+	}
+
+	beforeKeys := conflictKeys(beforeConflicts)
+	afterKeys := conflictKeys(afterConflicts)
+
+	var introduced, resolved, unchanged []conflict
+
+	for _, c := range afterConflicts {
+		if beforeKeys[c.key()] {
+			unchanged = append(unchanged, c)
+		} else {
+			introduced = append(introduced, c)
+		}
+	}
+
+	for _, c := range beforeConflicts {
+		if !afterKeys[c.key()] {
+			resolved = append(resolved, c)
+		}
+	}
+
+	for _, conflicts := range [][]conflict{introduced, resolved, unchanged} {
+		sortConflicts(conflicts, order)
+	}
+
+	added, removed, modified := diffEvents(before, after)
+
+	return models.CalendarDiffReport{
+		Introduced:     toConflicts(introduced, location),
+		Resolved:       toConflicts(resolved, location),
+		Unchanged:      toConflicts(unchanged, location),
+		AddedEvents:    added,
+		RemovedEvents:  removed,
+		ModifiedEvents: modified,
+	}, nil
+}
+
+// calendarConflicts find the confirmed double booked pairs of the calendar given according to the rules, the
+// pairs with a tentative event are left out like in the rest of the reports of confirmed conflicts
+func (r conflictRules) calendarConflicts(events models.Events) ([]conflict, error) {
+	parsed, err := parseIntervals(events)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := r.participating(parsed)
+	sortIntervals(intervals)
+
+	conflicts, _ := splitTentative(r.grade(sweepConflicts(intervals, r.mode)))
+
+	return conflicts, nil
+}
+
+// key identify the conflict by the IDs of its events and their occurrences
+func (c conflict) key() string {
+	return fmt.Sprintf("%d/%s/%d/%s",
+		c.first.event.ID, c.first.event.OccurrenceStart,
+		c.second.event.ID, c.second.event.OccurrenceStart,
+	)
+}
+
+// conflictKeys index the conflicts given by their key
+func conflictKeys(conflicts []conflict) map[string]bool {
+	keys := make(map[string]bool, len(conflicts))
+	for _, c := range conflicts {
+		keys[c.key()] = true
+	}
+
+	return keys
+}
+
+// diffEvents compare the events of both versions by ID, a recurring event is modified when any of its
+// occurrences changes. The IDs returned are sorted
+func diffEvents(before, after models.Events) ([]int, []int, []int) {
+	beforeByID := groupEventsByID(before)
+	afterByID := groupEventsByID(after)
+
+	added, removed, modified := map[int]bool{}, map[int]bool{}, map[int]bool{}
+
+	for id, afterEvents := range afterByID {
+		beforeEvents, ok := beforeByID[id]
+
+		switch {
+		case !ok:
+			added[id] = true
+		case !reflect.DeepEqual(beforeEvents, afterEvents):
+			modified[id] = true
+		}
+	}
+
+	for id := range beforeByID {
+		if _, ok := afterByID[id]; !ok {
+			removed[id] = true
+		}
+	}
+
+	return sortedBookings(added), sortedBookings(removed), sortedBookings(modified)
+}
+
+// groupEventsByID group the events given by ID, the occurrences of each recurring event are sorted by start
+func groupEventsByID(events models.Events) map[int]models.Events {
+	grouped := map[int]models.Events{}
+
+	for _, event := range events {
+		grouped[event.ID] = append(grouped[event.ID], event)
+	}
+
+	for _, group := range grouped {
+		sort.SliceStable(group, func(i, j int) bool { return group[i].Start < group[j].Start })
+	}
+
+	return grouped
+}
+
+// NewDiffCalendarsUC initialize this use case
+func NewDiffCalendarsUC() *DiffCalendarsUC {
+	return &DiffCalendarsUC{}
+}
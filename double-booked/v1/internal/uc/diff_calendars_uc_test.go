@@ -0,0 +1,216 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestDiffCalendarsUC_Handle test for this method
+func TestDiffCalendarsUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		before  models.Events
+		after   models.Events
+		options models.Options
+	}
+
+	before := models.Events{
+		models.Event{ID: 1, Start: "2023-02-02 12:00", End: "2023-02-02 13:00", Timezone: "UTC"},
+		models.Event{ID: 2, Start: "2023-02-02 12:30", End: "2023-02-02 13:30", Timezone: "UTC"},
+		models.Event{ID: 3, Start: "2023-02-02 15:00", End: "2023-02-02 16:00", Timezone: "UTC"},
+		models.Event{ID: 5, Start: "2023-02-02 15:30", End: "2023-02-02 16:30", Timezone: "UTC"},
+	}
+
+	after := models.Events{
+		models.Event{ID: 1, Start: "2023-02-02 12:00", End: "2023-02-02 13:00", Timezone: "UTC"},
+		models.Event{ID: 2, Start: "2023-02-02 12:45", End: "2023-02-02 13:45", Timezone: "UTC"},
+		models.Event{ID: 4, Start: "2023-02-02 13:30", End: "2023-02-02 14:00", Timezone: "UTC"},
+		models.Event{ID: 5, Start: "2023-02-02 15:30", End: "2023-02-02 16:30", Timezone: "UTC"},
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.CalendarDiffReport
+		wantErr bool
+	}{
+		{
+			name: "Conflicts introduced, resolved and unchanged",
+			args: args{
+				before: before,
+				after:  after,
+			},
+			want: models.CalendarDiffReport{
+				Introduced: models.Conflicts{
+					{
+						Events: []int{2, 4},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 13:30",
+							End:      "2023-02-02 13:45",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 13:30",
+							End:      "2023-02-02 13:45",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 900,
+						Severity:       models.SeverityWarning,
+					},
+				},
+				Resolved: models.Conflicts{
+					{
+						Events: []int{3, 5},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 15:30",
+							End:      "2023-02-02 16:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 15:30",
+							End:      "2023-02-02 16:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 1800,
+						Severity:       models.SeverityWarning,
+					},
+				},
+				Unchanged: models.Conflicts{
+					{
+						Events: []int{1, 2},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 12:45",
+							End:      "2023-02-02 13:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 12:45",
+							End:      "2023-02-02 13:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 900,
+						Severity:       models.SeverityWarning,
+					},
+				},
+				AddedEvents:    []int{4},
+				RemovedEvents:  []int{3},
+				ModifiedEvents: []int{2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Same calendar",
+			args: args{
+				before:  before[:2],
+				after:   before[:2],
+				options: models.Options{MinSeverity: models.SeverityCritical},
+			},
+			want: models.CalendarDiffReport{
+				Introduced:     models.Conflicts{},
+				Resolved:       models.Conflicts{},
+				Unchanged:      models.Conflicts{},
+				AddedEvents:    []int{},
+				RemovedEvents:  []int{},
+				ModifiedEvents: []int{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Tentative conflicts left out of the diff",
+			args: args{
+				before: before[:2],
+				after: models.Events{
+					before[0],
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 12:30",
+						End:      "2023-02-02 13:30",
+						Timezone: "UTC",
+						Status:   models.EventStatusTentative,
+					},
+				},
+			},
+			want: models.CalendarDiffReport{
+				Introduced: models.Conflicts{},
+				Resolved: models.Conflicts{
+					{
+						Events: []int{1, 2},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 12:30",
+							End:      "2023-02-02 13:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 12:30",
+							End:      "2023-02-02 13:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 1800,
+						Severity:       models.SeverityWarning,
+					},
+				},
+				Unchanged:      models.Conflicts{},
+				AddedEvents:    []int{},
+				RemovedEvents:  []int{},
+				ModifiedEvents: []int{2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid sort",
+			args: args{
+				before:  before,
+				after:   after,
+				options: models.Options{Sort: "WRONG"},
+			},
+			want:    models.CalendarDiffReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := &DiffCalendarsUC{}
+			got, err := uc.Handle(tt.args.before, tt.args.after, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewDiffCalendarsUC test for this method
+func TestNewDiffCalendarsUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *DiffCalendarsUC
+	}{
+		{
+			name: "Success",
+			want: NewDiffCalendarsUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewDiffCalendarsUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDiffCalendarsUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"time"
+)
+
+// dstProbe distance from a local time to the instants used to find the UTC offsets around it, it is longer than
+// any UTC offset so the offsets before and after a DST change are always found
+const dstProbe = 24 * time.Hour
+
+// dstResolver resolve the local times that fall in a DST gap or fold with the policy of the request, it keeps
+// every local time resolved to warn about it
+type dstResolver struct {
+	policy   models.DSTPolicy
+	resolved []dstResolution
+}
+
+// dstResolution local time in a DST gap or fold of its location and the instant chosen for it
+type dstResolution struct {
+	code       models.WarningCode
+	wallClock  time.Time
+	location   *time.Location
+	instant    time.Time
+	occurrence string
+}
+
+// dstError error returned when the policy rejects the local times in a DST gap or fold
+type dstError struct {
+	code      models.WarningCode
+	wallClock time.Time
+	location  *time.Location
+}
+
+// Error implements error
+func (e *dstError) Error() string {
+	return fmt.Sprintf("local time %s %s in %s", formatTime(e.wallClock), dstDescription(e.code), e.location)
+}
+
+// resolveDSTPolicy validate the DST policy given in the options, when it is empty earlier is used
+func resolveDSTPolicy(options models.Options) (models.DSTPolicy, error) {
+	switch options.DSTPolicy {
+	case "":
+		return models.DSTPolicyEarlier, nil
+	case models.DSTPolicyEarlier, models.DSTPolicyLater, models.DSTPolicyReject:
+		return options.DSTPolicy, nil
+	default:
+		return "", invalidOptionError("dst_policy", string(options.DSTPolicy))
+	}
+}
+
+// resolve find the instant of the wall clock time given, its fields are read as they are no matter its location.
+// A wall clock time has one instant, none when the clocks move forward over it (gap) and two when the clocks
+// move back over it (fold), in the last two cases the policy chooses the earlier or the later of the instants
+// given by the UTC offsets before and after the change, or rejects it. The occurrence identifies the local time
+// resolved when it is the start of an occurrence of a recurring event
+func (r *dstResolver) resolve(wallClock time.Time, location *time.Location, occurrence string) (time.Time, error) {
+	wallClock = wallClockOf(wallClock)
+
+	var candidates, instants []time.Time
+
+	for _, probe := range []time.Time{wallClock.Add(-dstProbe), wallClock.Add(dstProbe)} {
+		_, offset := probe.In(location).Zone()
+		instant := wallClock.Add(-time.Duration(offset) * time.Second)
+
+		if len(instants) > 0 && instants[0].Equal(instant) {
+			continue
+		}
+
+		instants = append(instants, instant)
+
+		if wallClockOf(instant.In(location)).Equal(wallClock) {
+			candidates = append(candidates, instant)
+		}
+	}
+
+	code := models.WarningCodeDSTFold
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0].In(location), nil
+	case 0:
+		code = models.WarningCodeDSTGap
+		candidates = instants
+	}
+
+	if r.policy == models.DSTPolicyReject {
+		return time.Time{}, &dstError{code: code, wallClock: wallClock, location: location}
+	}
+
+	earlier, later := candidates[0], candidates[len(candidates)-1]
+	if later.Before(earlier) {
+		earlier, later = later, earlier
+	}
+
+	instant := earlier
+	if r.policy == models.DSTPolicyLater {
+		instant = later
+	}
+
+	r.resolved = append(r.resolved, dstResolution{
+		code:       code,
+		wallClock:  wallClock,
+		location:   location,
+		instant:    instant,
+		occurrence: occurrence,
+	})
+
+	return instant.In(location), nil
+}
+
+// warnings build the warnings of the local times resolved for the event given
+func (r *dstResolver) warnings(event models.Event) models.Warnings {
+	warnings := make(models.Warnings, 0, len(r.resolved))
+
+	for _, resolution := range r.resolved {
+		warnings = append(warnings, models.Warning{
+			Code:        resolution.code,
+			Event:       event.ID,
+			Occurrence:  resolution.occurrence,
+			LocalTime:   formatTime(resolution.wallClock),
+			Timezone:    resolution.location.String(),
+			ResolvedUTC: formatTime(resolution.instant.UTC()),
+			Message: fmt.Sprintf("Local time %s %s in %s, the %s instant %s UTC is used",
+				formatTime(resolution.wallClock), dstDescription(resolution.code), resolution.location,
+				resolvedInstant(r.policy), formatTime(resolution.instant.UTC())),
+		})
+	}
+
+	return warnings
+}
+
+// seriesWallClock wall clock time at the clock given of the occurrence generated, the occurrences that fall in a
+// DST gap are moved by the location, so it is the day closest to the occurrence shown at that clock
+func seriesWallClock(generated time.Time, hour, minute, second int) time.Time {
+	shown := wallClockOf(generated)
+
+	var closest time.Time
+
+	for offset := -1; offset <= 1; offset++ {
+		wallClock := time.Date(shown.Year(), shown.Month(), shown.Day()+offset, hour, minute, second, 0, time.UTC)
+
+		if closest.IsZero() || absDuration(wallClock.Sub(shown)) < absDuration(closest.Sub(shown)) {
+			closest = wallClock
+		}
+	}
+
+	return closest
+}
+
+// wallClockOf wall clock time of the date time given, with the same fields in UTC to compare it with others
+func wallClockOf(dateTime time.Time) time.Time {
+	return time.Date(dateTime.Year(), dateTime.Month(), dateTime.Day(),
+		dateTime.Hour(), dateTime.Minute(), dateTime.Second(), 0, time.UTC)
+}
+
+// dstRejectedError build the error returned when an event is rejected by the DST policy
+func dstRejectedError(event models.Event, err *dstError) error {
+	return &models.EventError{
+		Code:       models.CodeParseEventError,
+		ID:         models.IDDoubleBookedError,
+		Message:    fmt.Sprintf("Error parsing local time of event %v: %s", event, err.Error()),
+		StatusCode: models.CodeStatusHTTPBusinessError,
+	}
+}
+
+// dstDescription describe the DST change of the warning code given
+func dstDescription(code models.WarningCode) string {
+	if code == models.WarningCodeDSTGap {
+		return "does not exist because of a DST change"
+	}
+
+	return "happens twice because of a DST change"
+}
+
+// resolvedInstant describe the instant chosen by the policy given
+func resolvedInstant(policy models.DSTPolicy) string {
+	if policy == models.DSTPolicyLater {
+		return "later"
+	}
+
+	return "earlier"
+}
@@ -0,0 +1,124 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoDuration ISO 8601 duration like PT45M or P1DT2H. The years, months, weeks and days are nominal, they move
+// the wall clock time by calendar units, and the hours, minutes and seconds are exact elapsed time
+type isoDuration struct {
+	years  int
+	months int
+	days   int
+	exact  time.Duration
+}
+
+// parseISODuration parse an ISO 8601 duration with whole numbers, like P1Y2M3W4DT5H6M7S. Every part is optional
+// but at least one of them must be given and the time parts must come after the T designator
+func parseISODuration(value string) (isoDuration, error) {
+	if len(value) < 2 || value[0] != 'P' {
+		return isoDuration{}, fmt.Errorf("invalid duration %q", value)
+	}
+
+	var (
+		duration isoDuration
+		number   string
+		inTime   bool
+		parts    int
+		order    = "YMWD"
+	)
+
+	for _, char := range value[1:] {
+		switch {
+		case char >= '0' && char <= '9':
+			number += string(char)
+
+			continue
+		case char == 'T' && !inTime && number == "":
+			inTime, order = true, "HMS"
+
+			continue
+		}
+
+		// Every designator closes a number and must follow the ones before it in the order of the section
+		designator := strings.IndexRune(order, char)
+		if number == "" || designator < 0 {
+			return isoDuration{}, fmt.Errorf("invalid duration %q", value)
+		}
+
+		amount, err := strconv.Atoi(number)
+		if err != nil {
+			return isoDuration{}, fmt.Errorf("invalid duration %q", value)
+		}
+
+		duration.add(inTime, char, amount)
+
+		order, number = order[designator+1:], ""
+		parts++
+	}
+
+	if number != "" || parts == 0 || (inTime && order == "HMS") {
+		return isoDuration{}, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return duration, nil
+}
+
+// add add the amount of the designator given to the duration, the time designators are exact
+func (d *isoDuration) add(inTime bool, designator rune, amount int) {
+	if inTime {
+		switch designator {
+		case 'H':
+			d.exact += time.Duration(amount) * time.Hour
+		case 'M':
+			d.exact += time.Duration(amount) * time.Minute
+		default:
+			d.exact += time.Duration(amount) * time.Second
+		}
+
+		return
+	}
+
+	switch designator {
+	case 'Y':
+		d.years += amount
+	case 'M':
+		d.months += amount
+	case 'W':
+		d.days += amount * 7
+	default:
+		d.days += amount
+	}
+}
+
+// nominal check if the duration moves the wall clock time by calendar units
+func (d isoDuration) nominal() bool {
+	return d.years != 0 || d.months != 0 || d.days != 0
+}
+
+// wholeDays check if the duration only has calendar units, as the durations of the all-day events
+func (d isoDuration) wholeDays() bool {
+	return d.nominal() && d.exact == 0
+}
+
+// after find the end of the duration that starts at the date time given. The calendar units move the wall clock
+// time in the location of the start, so a day lasts 23 or 25 hours across a DST change and the local time
+// reached is resolved with the resolver given, then the exact time is added
+func (d isoDuration) after(start time.Time, resolver *dstResolver, occurrence string) (time.Time, error) {
+	end := start
+
+	if d.nominal() {
+		wallClock := wallClockOf(start).AddDate(d.years, d.months, d.days)
+
+		var err error
+		if end, err = resolver.resolve(wallClock, start.Location(), occurrence); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return end.Add(d.exact), nil
+}
@@ -0,0 +1,103 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Test_parseISODuration test for this method
+func Test_parseISODuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    isoDuration
+		wantErr bool
+	}{
+		{
+			name:    "Minutes",
+			value:   "PT45M",
+			want:    isoDuration{exact: 45 * time.Minute},
+			wantErr: false,
+		},
+		{
+			name:    "Days and hours",
+			value:   "P1DT2H",
+			want:    isoDuration{days: 1, exact: 2 * time.Hour},
+			wantErr: false,
+		},
+		{
+			name:    "Every part",
+			value:   "P1Y2M3W4DT5H6M7S",
+			want:    isoDuration{years: 1, months: 2, days: 25, exact: 5*time.Hour + 6*time.Minute + 7*time.Second},
+			wantErr: false,
+		},
+		{
+			name:    "Zero length",
+			value:   "PT0S",
+			want:    isoDuration{},
+			wantErr: false,
+		},
+		{
+			name:    "Error without parts",
+			value:   "P",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+		{
+			name:    "Error without time parts after the designator",
+			value:   "P1DT",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+		{
+			name:    "Error with parts out of order",
+			value:   "PT5M1H",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+		{
+			name:    "Error with hours before the time designator",
+			value:   "P2H",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+		{
+			name:    "Error with a fraction",
+			value:   "PT1.5H",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+		{
+			name:    "Error with a negative duration",
+			value:   "-PT1H",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+		{
+			name:    "Error with a Go duration",
+			value:   "45m",
+			want:    isoDuration{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseISODuration(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseISODuration() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseISODuration() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
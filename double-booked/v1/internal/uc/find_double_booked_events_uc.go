@@ -3,114 +3,140 @@ package uc
 
 import (
 	"LiteraTest/double-booked/v1/internal/models"
-	"fmt"
-	"sync"
-	"time"
+	"container/heap"
 )
 
 // FindDoubleBookedEventsUC declaration of use case struct used in this file
 type FindDoubleBookedEventsUC struct{}
 
-// Handle find all the double booked events in the list of events given
-func (uc *FindDoubleBookedEventsUC) Handle(events models.Events) (models.DoubleBookedEvents, error) {
-	// variables used for manage concurrency
-	var (
-		waitGroup sync.WaitGroup
-		mutex     sync.Mutex
-	)
-
-	waitGroup.Add(len(events))
-
-	var doubleBookedEvents models.DoubleBookedEvents
-
-	// We will loop the events, and we compare each event if overlapping another event
-	for _, event := range events {
-		// We will create one go routine for each event in the list to check overlapping
-		go func(event models.Event) {
-			defer waitGroup.Done()
-
-			for _, eventToCheck := range events {
-				if event.ID == eventToCheck.ID {
-					continue
-				}
-
-				// We check if the event is a double booking adding in a list if its match
-				isDoubleBookedCheck, _ := inTimeSpan(event, eventToCheck)
-				if isDoubleBookedCheck {
-					mutex.Lock()
-					if !isAlreadyInList(event.ID, eventToCheck.ID, doubleBookedEvents) {
-						doubleBookedEvents = append(doubleBookedEvents, []int{event.ID, eventToCheck.ID})
-					}
-					mutex.Unlock()
-				}
-			}
-		}(event)
+// Handle find all the double booked events in the list of events given.
+// The events are sorted by start and swept once keeping a min-heap of the active events ordered by end,
+// so the whole process runs in O(n log n + k) where k is the number of double booked pairs
+func (uc *FindDoubleBookedEventsUC) Handle(
+	events models.Events,
+	options models.Options,
+) (models.DoubleBookedReport, error) {
+	rules, err := resolveConflictRules(options)
+	if err != nil {
+		return models.DoubleBookedReport{}, err
 	}
 
-	waitGroup.Wait()
-
-	return doubleBookedEvents, nil
-}
-
-// isAlreadyInList check if an events pair is already in the list of events given
-func isAlreadyInList(eventID, eventToCheckID int, eventsList models.DoubleBookedEvents) bool {
-	for _, pair := range eventsList {
-		if (eventID == pair[0] && eventToCheckID == pair[1]) || (eventID == pair[1] && eventToCheckID == pair[0]) {
-			return true
-		}
+	order, err := resolveSortOrder(options)
+	if err != nil {
+		return models.DoubleBookedReport{}, err
 	}
 
-	return false
-}
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.DoubleBookedReport{}, err
+	}
 
-// inTimeSpan check if an event is overlapping another event
-func inTimeSpan(event, eventToCheck models.Event) (bool, error) {
-	startToCheck, err := time.Parse(LayoutFormat, eventToCheck.Start)
+	capacities, err := resolveCapacities(options)
 	if err != nil {
-		return false, &models.EventError{
-			Code:       models.CodeFindDoubleBookedError,
-			ID:         models.IDDoubleBookedError,
-			Message:    fmt.Sprintf("Error parsing timezone of UTC event %v", event),
-			StatusCode: models.CodeStatusHTTPBusinessError,
-		}
+		return models.DoubleBookedReport{}, err
 	}
 
-	endToCheck, err := time.Parse(LayoutFormat, eventToCheck.End)
+	defaultBefore, defaultAfter, err := resolveBuffers(options)
 	if err != nil {
-		return false, &models.EventError{
-			Code:       models.CodeFindDoubleBookedError,
-			ID:         models.IDDoubleBookedError,
-			Message:    fmt.Sprintf("Error parsing timezone of UTC event %v", event),
-			StatusCode: models.CodeStatusHTTPBusinessError,
-		}
+		return models.DoubleBookedReport{}, err
 	}
 
-	start, err := time.Parse(LayoutFormat, event.Start)
+	parsed, err := parseIntervals(events)
 	if err != nil {
-		return false, &models.EventError{
-			Code:       models.CodeFindDoubleBookedError,
-			ID:         models.IDDoubleBookedError,
-			Message:    fmt.Sprintf("Error parsing timezone of UTC event %v", event),
-			StatusCode: models.CodeStatusHTTPBusinessError,
-		}
+		return models.DoubleBookedReport{}, err
 	}
 
-	end, err := time.Parse(LayoutFormat, event.End)
+	intervals := rules.participating(parsed)
+	sortIntervals(intervals)
+
+	conflicts, tentativeConflicts := splitTentative(rules.grade(sweepConflicts(intervals, rules.mode)))
+
+	// The heap order depends on the input, so the pairs are sorted to always give the same response
+	sortConflicts(conflicts, order)
+	sortConflicts(tentativeConflicts, order)
+
+	bufferConflicts, err := findBufferConflicts(intervals, defaultBefore, defaultAfter, rules.mode, rules.allowed)
 	if err != nil {
-		return false, &models.EventError{
-			Code:       models.CodeFindDoubleBookedError,
-			ID:         models.IDDoubleBookedError,
-			Message:    fmt.Sprintf("Error parsing timezone of UTC event %v", event),
-			StatusCode: models.CodeStatusHTTPBusinessError,
-		}
+		return models.DoubleBookedReport{}, err
+	}
+
+	sortConflicts(bufferConflicts, order)
+
+	report := models.DoubleBookedReport{
+		DoubleBookedEvents:      toDoubleBookedEvents(conflicts),
+		DoubleBookedOccurrences: toDoubleBookedOccurrences(conflicts),
+		DoubleBookedAttendees:   toDoubleBookedAttendees(conflicts),
+	}
+
+	if resourceConflicts := findResourceConflicts(intervals, capacities); len(resourceConflicts) > 0 {
+		report.ResourceConflicts = toResourceConflicts(resourceConflicts, location)
+	}
+
+	if len(tentativeConflicts) > 0 {
+		report.TentativeDoubleBookedEvents = toDoubleBookedEvents(tentativeConflicts)
+		report.TentativeDoubleBookedOccurrences = toDoubleBookedOccurrences(tentativeConflicts)
+		report.TentativeDoubleBookedAttendees = toDoubleBookedAttendees(tentativeConflicts)
 	}
 
-	if (start.After(startToCheck) && start.Before(endToCheck)) ||
-		(end.After(startToCheck) && end.Before(endToCheck)) {
-		return true, nil
+	if len(tentativeConflicts) > 0 && options.IncludeDetails {
+		report.TentativeConflicts = toConflicts(tentativeConflicts, location)
+	}
+
+	if len(bufferConflicts) > 0 {
+		report.BufferConflicts = toBufferConflicts(bufferConflicts, location)
+	}
+
+	if options.IncludeDetails {
+		report.Conflicts = toConflicts(conflicts, location)
+	}
+
+	if options.IncludeClusters {
+		report.Clusters = toClusters(buildClusters(conflicts), location)
+	}
+
+	if options.IncludeResolution {
+		plan := buildResolutionPlan(conflicts, rules.mode)
+		report.Resolution = &plan
+	}
+
+	return report, nil
+}
+
+// sweepConflicts find the double booked pairs of the intervals given, they must be sorted by start
+func sweepConflicts(intervals []interval, mode models.OverlapMode) []conflict {
+	var conflicts []conflict
+
+	active := &intervalHeap{}
+
+	for _, current := range intervals {
+		// Every active event that ends before the current one starts can not overlap anything else
+		for active.Len() > 0 && hasEnded((*active)[0], current.start, mode) {
+			heap.Pop(active)
+		}
+
+		// The remaining active events started before and are still running, so they overlap except for
+		// the zero length events sharing a boundary that the overlap mode may exclude
+		for _, booked := range *active {
+			if booked.event.ID == current.event.ID || !overlaps(booked, current, mode) {
+				continue
+			}
+
+			// Events with attendees are only double booked when someone attends both of them
+			attendees, ok := doubleBookedAttendees(booked, current)
+			if !ok {
+				continue
+			}
+
+			doubleBooked := newConflict(booked, current)
+			doubleBooked.attendees = attendees
+
+			conflicts = append(conflicts, doubleBooked)
+		}
+
+		heap.Push(active, current)
 	}
 
-	return false, nil
+	return conflicts
 }
 
 // NewFindDoubleBookedEventsUC initialize this use case
@@ -3,26 +3,31 @@ package uc
 
 import (
 	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"math/rand"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestFindDoubleBookedEventsUC_Handle test for this method
 func TestFindDoubleBookedEventsUC_Handle(t *testing.T) {
 	type args struct {
-		events models.Events
+		events  models.Events
+		options models.Options
 	}
 
 	tests := []struct {
 		name    string
 		args    args
-		want    models.DoubleBookedEvents
+		want    models.DoubleBookedReport
 		wantErr bool
 	}{
 		{
 			name: "Success with double-booked events",
 			args: args{
-				models.Events{
+				events: models.Events{
 					models.Event{
 						ID:       1,
 						Start:    "2023-02-02 18:00",
@@ -43,13 +48,13 @@ func TestFindDoubleBookedEventsUC_Handle(t *testing.T) {
 					},
 				},
 			},
-			want:    models.DoubleBookedEvents{{3, 2}},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{2, 3}}},
 			wantErr: false,
 		},
 		{
 			name: "Fail by start date time",
 			args: args{
-				models.Events{
+				events: models.Events{
 					models.Event{
 						ID:       1,
 						Start:    "WRONG",
@@ -70,34 +75,1252 @@ func TestFindDoubleBookedEventsUC_Handle(t *testing.T) {
 					},
 				},
 			},
-			want:    models.DoubleBookedEvents{{3, 2}},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by end date time",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "WRONG",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 21:00",
+						End:      "2023-02-02 23:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 20:00",
+						End:      "2023-02-02 22:00",
+						Timezone: "UTC",
+					},
+				},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Success with contained and back to back events",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 22:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 19:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 22:00",
+						End:      "2023-02-02 23:00",
+						Timezone: "UTC",
+					},
+				},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}}},
+			wantErr: false,
+		},
+		{
+			name: "Success with identical and same start events",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 18:30",
+						Timezone: "UTC",
+					},
+				},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {1, 3}, {2, 3}}},
+			wantErr: false,
+		},
+		{
+			name: "Success back to back events with closed mode",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 19:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 20:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{OverlapMode: models.OverlapModeClosed},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {2, 3}}},
+			wantErr: false,
+		},
+		{
+			name: "Success back to back events with touching counts mode",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 19:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 20:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{OverlapMode: models.OverlapModeTouchingCounts},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}}},
+			wantErr: false,
+		},
+		{
+			name: "Success sorted by start",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 23:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 17:00",
+						End:      "2023-02-02 18:30",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 21:00",
+						End:      "2023-02-02 21:30",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{Sort: models.SortByStart},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 4}, {1, 2}}},
+			wantErr: false,
+		},
+		{
+			name: "Success sorted by duration",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 23:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 17:00",
+						End:      "2023-02-02 18:30",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 21:00",
+						End:      "2023-02-02 22:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{Sort: models.SortByDuration},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {1, 4}}},
+			wantErr: false,
+		},
+		{
+			name: "Success with details in the timezone given",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:15",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeDetails: true, Timezone: "America/Bogota"},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}},
+				Conflicts: models.Conflicts{
+					{
+						Events: []int{1, 2},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 18:15",
+							End:      "2023-02-02 19:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 13:15",
+							End:      "2023-02-02 14:00",
+							Timezone: "America/Bogota",
+						},
+						OverlapSeconds: 2700,
+						Severity:       models.SeverityCritical,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with occurrences of recurring events",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:              1,
+						Start:           "2023-02-02 18:00",
+						End:             "2023-02-02 19:00",
+						Timezone:        "UTC",
+						OccurrenceStart: "2023-02-02 13:00",
+					},
+					models.Event{
+						ID:              1,
+						Start:           "2023-02-03 18:00",
+						End:             "2023-02-03 19:00",
+						Timezone:        "UTC",
+						OccurrenceStart: "2023-02-03 13:00",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-03 18:30",
+						End:      "2023-02-03 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-03 18:10",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeDetails: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {1, 3}, {1, 3}},
+				DoubleBookedOccurrences: models.DoubleBookedOccurrences{
+					{"2023-02-03 13:00", ""},
+					{"2023-02-02 13:00", ""},
+					{"2023-02-03 13:00", ""},
+				},
+				Conflicts: models.Conflicts{
+					{
+						Events:      []int{1, 2},
+						Occurrences: []string{"2023-02-03 13:00", ""},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-03 18:30",
+							End:      "2023-02-03 19:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-03 18:30",
+							End:      "2023-02-03 19:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 1800,
+						Severity:       models.SeverityWarning,
+					},
+					{
+						Events:      []int{1, 3},
+						Occurrences: []string{"2023-02-02 13:00", ""},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 18:00",
+							End:      "2023-02-02 19:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 18:00",
+							End:      "2023-02-02 19:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 3600,
+						Severity:       models.SeverityCritical,
+					},
+					{
+						Events:      []int{1, 3},
+						Occurrences: []string{"2023-02-03 13:00", ""},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-03 18:00",
+							End:      "2023-02-03 18:10",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-03 18:00",
+							End:      "2023-02-03 18:10",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 600,
+						Severity:       models.SeverityInfo,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with clusters",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:30",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 19:30",
+						End:      "2023-02-02 21:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 21:00",
+						End:      "2023-02-02 22:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeClusters: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {2, 3}},
+				Clusters: models.Clusters{
+					{
+						Events: []int{1, 2, 3},
+						SpanUTC: models.TimeWindow{
+							Start:    "2023-02-02 18:00",
+							End:      "2023-02-02 21:00",
+							Timezone: "UTC",
+						},
+						SpanLocal: models.TimeWindow{
+							Start:    "2023-02-02 18:00",
+							End:      "2023-02-02 21:00",
+							Timezone: "UTC",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with all-day events",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-01 05:00",
+						End:      "2023-02-04 05:00",
+						Timezone: "UTC",
+						AllDay:   true,
+					},
+					models.Event{
+						ID:            3,
+						Start:         "2023-02-02 05:00",
+						End:           "2023-02-03 05:00",
+						Timezone:      "UTC",
+						AllDay:        true,
+						Informational: true,
+					},
+				},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}}},
+			wantErr: false,
+		},
+		{
+			name: "Success excluding all-day events",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-01 05:00",
+						End:      "2023-02-04 05:00",
+						Timezone: "UTC",
+						AllDay:   true,
+					},
+					models.Event{
+						ID:            3,
+						Start:         "2023-02-02 05:00",
+						End:           "2023-02-03 05:00",
+						Timezone:      "UTC",
+						AllDay:        true,
+						Informational: true,
+					},
+				},
+				options: models.Options{ExcludeAllDay: true},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{}},
+			wantErr: false,
+		},
+		{
+			name: "Success with buffer conflicts",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 19:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:           3,
+						Start:        "2023-02-02 20:30",
+						End:          "2023-02-02 21:00",
+						Timezone:     "UTC",
+						BufferBefore: "0m",
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 19:30",
+						End:      "2023-02-02 19:45",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{BufferAfter: "10m", Timezone: "America/Bogota"},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{2, 4}},
+				BufferConflicts: models.BufferConflicts{
+					{
+						Events: []int{1, 2},
+						PaddedUTC: []models.TimeWindow{
+							{Start: "2023-02-02 18:00", End: "2023-02-02 19:10", Timezone: "UTC"},
+							{Start: "2023-02-02 19:00", End: "2023-02-02 20:10", Timezone: "UTC"},
+						},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 19:00",
+							End:      "2023-02-02 19:10",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 14:00",
+							End:      "2023-02-02 14:10",
+							Timezone: "America/Bogota",
+						},
+						OverlapSeconds: 600,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with a tolerated overlap as a buffer conflict",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:58",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{BufferAfter: "10m", ToleranceMinutes: 5},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{},
+				BufferConflicts: models.BufferConflicts{
+					{
+						Events: []int{1, 2},
+						PaddedUTC: []models.TimeWindow{
+							{Start: "2023-02-02 18:00", End: "2023-02-02 19:10", Timezone: "UTC"},
+							{Start: "2023-02-02 18:58", End: "2023-02-02 20:10", Timezone: "UTC"},
+						},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 18:58",
+							End:      "2023-02-02 19:10",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 18:58",
+							End:      "2023-02-02 19:10",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 720,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid buffer of an event",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:          1,
+						Start:       "2023-02-02 18:00",
+						End:         "2023-02-02 19:00",
+						Timezone:    "UTC",
+						BufferAfter: "WRONG",
+					},
+				},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid default buffer",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{BufferBefore: "-5m"},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Success ignoring overlaps within the tolerance in minutes",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:58",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 19:30",
+						End:      "2023-02-02 19:50",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{ToleranceMinutes: 2},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{2, 3}}},
+			wantErr: false,
+		},
+		{
+			name: "Success ignoring overlaps within the tolerance percentage",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:45",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{TolerancePercent: 25},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{}},
+			wantErr: false,
+		},
+		{
+			name: "Success filtering by minimum severity",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:50",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 18:15",
+						End:      "2023-02-02 18:45",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{MinSeverity: models.SeverityCritical},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 3}}},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid minimum severity",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{MinSeverity: "WRONG"},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Success with overlaps of seconds",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 09:00",
+						End:      "2023-02-02 10:00:30",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 10:00",
+						End:      "2023-02-02 11:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeDetails: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}},
+				Conflicts: models.Conflicts{
+					{
+						Events: []int{1, 2},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 10:00",
+							End:      "2023-02-02 10:00:30",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 10:00",
+							End:      "2023-02-02 10:00:30",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 30,
+						Severity:       models.SeverityInfo,
+					},
+				},
+			},
 			wantErr: false,
 		},
 		{
-			name: "Fail by end date time",
+			name: "Success with resolution plan",
 			args: args{
-				models.Events{
+				events: models.Events{
 					models.Event{
 						ID:       1,
-						Start:    "2023-02-02 18:00",
-						End:      "WRONG",
+						Start:    "2023-02-02 09:00",
+						End:      "2023-02-02 10:00",
 						Timezone: "UTC",
 					},
 					models.Event{
 						ID:       2,
-						Start:    "2023-02-02 21:00",
-						End:      "2023-02-02 23:00",
+						Start:    "2023-02-02 09:30",
+						End:      "2023-02-02 11:00",
 						Timezone: "UTC",
+						Priority: 5,
 					},
 					models.Event{
 						ID:       3,
-						Start:    "2023-02-02 20:00",
-						End:      "2023-02-02 22:00",
+						Start:    "2023-02-02 10:30",
+						End:      "2023-02-02 12:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 11:30",
+						End:      "2023-02-02 12:30",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeResolution: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {2, 3}, {3, 4}},
+				Resolution: &models.ResolutionPlan{
+					KeptPriority:     6,
+					DeclinedPriority: 2,
+					Steps: []models.ResolutionStep{
+						{
+							Action:        models.ResolutionActionDecline,
+							Event:         1,
+							Priority:      1,
+							ConflictsWith: []int{2},
+							Explanation: "Decline or move event 1 (priority 1), it is double booked with the kept " +
+								"events 2 (total priority 5)",
+						},
+						{
+							Action:        models.ResolutionActionDecline,
+							Event:         3,
+							Priority:      1,
+							ConflictsWith: []int{2, 4},
+							Explanation: "Decline or move event 3 (priority 1), it is double booked with the kept " +
+								"events 2, 4 (total priority 6)",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with resolution plan without conflicts",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 09:00",
+						End:      "2023-02-02 10:00",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeResolution: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{},
+				Resolution:         &models.ResolutionPlan{Steps: []models.ResolutionStep{}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with status and transparency",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:30",
+						End:      "2023-02-02 19:30",
+						Timezone: "UTC",
+						Status:   models.EventStatusCancelled,
+					},
+					models.Event{
+						ID:           3,
+						Start:        "2023-02-02 18:00",
+						End:          "2023-02-02 18:30",
+						Timezone:     "UTC",
+						Transparency: models.TransparencyTransparent,
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 18:45",
+						End:      "2023-02-02 19:15",
+						Timezone: "UTC",
+						Status:   models.EventStatusTentative,
+					},
+				},
+				options: models.Options{IncludeDetails: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents:          models.DoubleBookedEvents{},
+				Conflicts:                   models.Conflicts{},
+				TentativeDoubleBookedEvents: models.DoubleBookedEvents{{1, 4}},
+				TentativeConflicts: models.Conflicts{
+					{
+						Events: []int{1, 4},
+						OverlapUTC: models.TimeWindow{
+							Start:    "2023-02-02 18:45",
+							End:      "2023-02-02 19:00",
+							Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start:    "2023-02-02 18:45",
+							End:      "2023-02-02 19:00",
+							Timezone: "UTC",
+						},
+						OverlapSeconds: 900,
+						Severity:       models.SeverityWarning,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with the statuses and transparent events given",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:30",
+						End:      "2023-02-02 19:30",
+						Timezone: "UTC",
+						Status:   models.EventStatusCancelled,
+					},
+					models.Event{
+						ID:           3,
+						Start:        "2023-02-02 18:00",
+						End:          "2023-02-02 18:30",
+						Timezone:     "UTC",
+						Transparency: models.TransparencyTransparent,
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 18:45",
+						End:      "2023-02-02 19:15",
+						Timezone: "UTC",
+						Status:   models.EventStatusTentative,
+					},
+				},
+				options: models.Options{
+					Statuses:           []models.EventStatus{models.EventStatusConfirmed, models.EventStatusCancelled},
+					IncludeTransparent: true,
+				},
+			},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{{1, 2}, {1, 3}}},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid status in the options",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{Statuses: []models.EventStatus{"declined"}},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Success with shared attendees without details",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:        1,
+						Start:     "2023-02-02 18:00",
+						End:       "2023-02-02 19:00",
+						Timezone:  "UTC",
+						Attendees: []string{"ana", "max"},
+					},
+					models.Event{
+						ID:        2,
+						Start:     "2023-02-02 18:30",
+						End:       "2023-02-02 19:30",
+						Timezone:  "UTC",
+						Attendees: []string{"max", "leo"},
+					},
+					models.Event{
+						ID:        3,
+						Start:     "2023-02-02 18:45",
+						End:       "2023-02-02 19:15",
+						Timezone:  "UTC",
+						Attendees: []string{"ana"},
+					},
+				},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents:    models.DoubleBookedEvents{{1, 2}, {1, 3}},
+				DoubleBookedAttendees: models.DoubleBookedAttendees{{"max"}, {"ana"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with shared attendees",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:        1,
+						Start:     "2023-02-02 18:00",
+						End:       "2023-02-02 19:00",
+						Timezone:  "UTC",
+						Attendees: []string{"ana", "max", "ana"},
+					},
+					models.Event{
+						ID:        2,
+						Start:     "2023-02-02 18:30",
+						End:       "2023-02-02 19:30",
+						Timezone:  "UTC",
+						Attendees: []string{"max", "ana", "leo"},
+					},
+					models.Event{
+						ID:        3,
+						Start:     "2023-02-02 18:15",
+						End:       "2023-02-02 18:45",
+						Timezone:  "UTC",
+						Attendees: []string{"leo"},
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 18:10",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{IncludeDetails: true},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents:    models.DoubleBookedEvents{{1, 2}, {1, 4}, {2, 3}},
+				DoubleBookedAttendees: models.DoubleBookedAttendees{{"ana", "max"}, {}, {"leo"}},
+				Conflicts: models.Conflicts{
+					{
+						Events: []int{1, 2},
+						OverlapUTC: models.TimeWindow{
+							Start: "2023-02-02 18:30", End: "2023-02-02 19:00", Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start: "2023-02-02 18:30", End: "2023-02-02 19:00", Timezone: "UTC",
+						},
+						OverlapSeconds: 1800,
+						Severity:       models.SeverityWarning,
+						Attendees:      []string{"ana", "max"},
+					},
+					{
+						Events: []int{1, 4},
+						OverlapUTC: models.TimeWindow{
+							Start: "2023-02-02 18:00", End: "2023-02-02 18:10", Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start: "2023-02-02 18:00", End: "2023-02-02 18:10", Timezone: "UTC",
+						},
+						OverlapSeconds: 600,
+						Severity:       models.SeverityCritical,
+					},
+					{
+						Events: []int{2, 3},
+						OverlapUTC: models.TimeWindow{
+							Start: "2023-02-02 18:30", End: "2023-02-02 18:45", Timezone: "UTC",
+						},
+						OverlapLocal: models.TimeWindow{
+							Start: "2023-02-02 18:30", End: "2023-02-02 18:45", Timezone: "UTC",
+						},
+						OverlapSeconds: 900,
+						Severity:       models.SeverityWarning,
+						Attendees:      []string{"leo"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with the same attendee in bookings of different resources",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:         1,
+						Start:      "2023-02-02 10:00",
+						End:        "2023-02-02 11:00",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+						Attendees:  []string{"ana"},
+					},
+					models.Event{
+						ID:         2,
+						Start:      "2023-02-02 10:30",
+						End:        "2023-02-02 11:30",
+						Timezone:   "UTC",
+						ResourceID: "room-b",
+						Attendees:  []string{"ana"},
+					},
+				},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents:    models.DoubleBookedEvents{{1, 2}},
+				DoubleBookedAttendees: models.DoubleBookedAttendees{{"ana"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with the same attendee in two bookings of a resource with capacity",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:         1,
+						Start:      "2023-02-02 13:00",
+						End:        "2023-02-02 14:00",
+						Timezone:   "UTC",
+						ResourceID: "room",
+						Attendees:  []string{"alice"},
+					},
+					models.Event{
+						ID:         2,
+						Start:      "2023-02-02 13:00",
+						End:        "2023-02-02 14:00",
+						Timezone:   "UTC",
+						ResourceID: "room",
+						Attendees:  []string{"alice"},
+					},
+				},
+				options: models.Options{Resources: models.Resources{{ID: "room", Capacity: 2}}},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents:    models.DoubleBookedEvents{{1, 2}},
+				DoubleBookedAttendees: models.DoubleBookedAttendees{{"alice"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with resources over capacity",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:         1,
+						Start:      "2023-02-02 18:00",
+						End:        "2023-02-02 20:00",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+						Attendees:  []string{"ana"},
+					},
+					models.Event{
+						ID:         2,
+						Start:      "2023-02-02 18:30",
+						End:        "2023-02-02 19:30",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+						Attendees:  []string{"ben"},
+					},
+					models.Event{
+						ID:         3,
+						Start:      "2023-02-02 19:00",
+						End:        "2023-02-02 21:00",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+						Attendees:  []string{"cal"},
+					},
+					models.Event{
+						ID:         4,
+						Start:      "2023-02-02 18:00",
+						End:        "2023-02-02 19:00",
+						Timezone:   "UTC",
+						ResourceID: "room-b",
+						Attendees:  []string{"dan"},
+					},
+					models.Event{
+						ID:         5,
+						Start:      "2023-02-02 19:00",
+						End:        "2023-02-02 20:00",
+						Timezone:   "UTC",
+						ResourceID: "room-b",
+						Attendees:  []string{"eve"},
+					},
+					models.Event{
+						ID:         6,
+						Start:      "2023-02-02 19:30",
+						End:        "2023-02-02 19:45",
+						Timezone:   "UTC",
+						ResourceID: "room-b",
+						Attendees:  []string{"fay"},
+					},
+					models.Event{
+						ID:       7,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 18:15",
 						Timezone: "UTC",
 					},
 				},
+				options: models.Options{
+					Timezone:  "America/Bogota",
+					Resources: models.Resources{{ID: "room-a", Capacity: 2}},
+				},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{{1, 7}, {4, 7}},
+				ResourceConflicts: models.ResourceConflicts{
+					{
+						ResourceID: "room-a",
+						Capacity:   2,
+						Peak:       3,
+						WindowUTC: models.TimeWindow{
+							Start: "2023-02-02 19:00", End: "2023-02-02 19:30", Timezone: "UTC",
+						},
+						WindowLocal: models.TimeWindow{
+							Start: "2023-02-02 14:00", End: "2023-02-02 14:30", Timezone: "America/Bogota",
+						},
+						Bookings: []int{1, 2, 3},
+					},
+					{
+						ResourceID: "room-b",
+						Capacity:   1,
+						Peak:       2,
+						WindowUTC: models.TimeWindow{
+							Start: "2023-02-02 19:30", End: "2023-02-02 19:45", Timezone: "UTC",
+						},
+						WindowLocal: models.TimeWindow{
+							Start: "2023-02-02 14:30", End: "2023-02-02 14:45", Timezone: "America/Bogota",
+						},
+						Bookings: []int{5, 6},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with an occurrence of a recurring booking over capacity",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:              1,
+						Start:           "2023-02-02 18:00",
+						End:             "2023-02-02 19:00",
+						Timezone:        "UTC",
+						ResourceID:      "room",
+						Attendees:       []string{"ana"},
+						OccurrenceStart: "2023-02-02 13:00",
+					},
+					models.Event{
+						ID:              1,
+						Start:           "2023-02-03 18:00",
+						End:             "2023-02-03 19:00",
+						Timezone:        "UTC",
+						ResourceID:      "room",
+						Attendees:       []string{"ana"},
+						OccurrenceStart: "2023-02-03 13:00",
+					},
+					models.Event{
+						ID:         2,
+						Start:      "2023-02-03 18:30",
+						End:        "2023-02-03 19:30",
+						Timezone:   "UTC",
+						ResourceID: "room",
+						Attendees:  []string{"ben"},
+					},
+				},
+			},
+			want: models.DoubleBookedReport{
+				DoubleBookedEvents: models.DoubleBookedEvents{},
+				ResourceConflicts: models.ResourceConflicts{
+					{
+						ResourceID: "room",
+						Capacity:   1,
+						Peak:       2,
+						WindowUTC: models.TimeWindow{
+							Start: "2023-02-03 18:30", End: "2023-02-03 19:00", Timezone: "UTC",
+						},
+						WindowLocal: models.TimeWindow{
+							Start: "2023-02-03 18:30", End: "2023-02-03 19:00", Timezone: "UTC",
+						},
+						Bookings:    []int{1, 2},
+						Occurrences: []string{"2023-02-03 13:00", ""},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid resource capacity",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{Resources: models.Resources{{ID: "room-a", Capacity: -1}}},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by resource without capacity",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{Resources: models.Resources{{ID: "room-a", Capacity: 0}}},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid timezone",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{Timezone: "WRONG"},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid sort",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{Sort: "WRONG"},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid overlap mode",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{OverlapMode: "WRONG"},
+			},
+			want:    models.DoubleBookedReport{},
+			wantErr: true,
+		},
+		{
+			name: "Success without events",
+			args: args{
+				events: models.Events{},
 			},
-			want:    models.DoubleBookedEvents{{3, 2}},
+			want:    models.DoubleBookedReport{DoubleBookedEvents: models.DoubleBookedEvents{}},
 			wantErr: false,
 		},
 	}
@@ -105,7 +1328,7 @@ func TestFindDoubleBookedEventsUC_Handle(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uc := &FindDoubleBookedEventsUC{}
-			got, err := uc.Handle(tt.args.events)
+			got, err := uc.Handle(tt.args.events, tt.args.options)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
 
@@ -118,6 +1341,35 @@ func TestFindDoubleBookedEventsUC_Handle(t *testing.T) {
 	}
 }
 
+// TestFindDoubleBookedEventsUC_Handle_Deterministic check the response does not depend on the events order
+func TestFindDoubleBookedEventsUC_Handle_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	uc := NewFindDoubleBookedEventsUC()
+	events := generateCalendar(256)
+
+	want, err := uc.Handle(events, models.Options{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	random := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10; i++ {
+		shuffled := append(models.Events{}, events...)
+		random.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		got, err := uc.Handle(shuffled, models.Options{})
+		if err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Handle() got = %v, want %v", got, want)
+		}
+	}
+}
+
 // TestNewFindDoubleBookedEventsUC test for this method
 func TestNewFindDoubleBookedEventsUC(t *testing.T) {
 	t.Parallel()
@@ -142,3 +1394,112 @@ func TestNewFindDoubleBookedEventsUC(t *testing.T) {
 		})
 	}
 }
+
+// pairwiseDoubleBookedEvents previous goroutine per event implementation, kept only as a benchmark baseline
+func pairwiseDoubleBookedEvents(events models.Events) models.DoubleBookedEvents {
+	var (
+		waitGroup sync.WaitGroup
+		mutex     sync.Mutex
+	)
+
+	waitGroup.Add(len(events))
+
+	var doubleBookedEvents models.DoubleBookedEvents
+
+	for _, event := range events {
+		go func(event models.Event) {
+			defer waitGroup.Done()
+
+			for _, eventToCheck := range events {
+				if event.ID == eventToCheck.ID {
+					continue
+				}
+
+				startToCheck, _ := time.Parse(LayoutFormat, eventToCheck.Start)
+				endToCheck, _ := time.Parse(LayoutFormat, eventToCheck.End)
+				start, _ := time.Parse(LayoutFormat, event.Start)
+				end, _ := time.Parse(LayoutFormat, event.End)
+
+				if (start.After(startToCheck) && start.Before(endToCheck)) ||
+					(end.After(startToCheck) && end.Before(endToCheck)) {
+					mutex.Lock()
+
+					alreadyInList := false
+
+					for _, pair := range doubleBookedEvents {
+						if (event.ID == pair[0] && eventToCheck.ID == pair[1]) ||
+							(event.ID == pair[1] && eventToCheck.ID == pair[0]) {
+							alreadyInList = true
+
+							break
+						}
+					}
+
+					if !alreadyInList {
+						doubleBookedEvents = append(doubleBookedEvents, []int{event.ID, eventToCheck.ID})
+					}
+					mutex.Unlock()
+				}
+			}
+		}(event)
+	}
+
+	waitGroup.Wait()
+
+	return doubleBookedEvents
+}
+
+// generateCalendar build a random calendar with around eight events per day of 15 to 120 minutes each
+func generateCalendar(size int) models.Events {
+	random := rand.New(rand.NewSource(int64(size)))
+	firstDay := time.Date(2023, 2, 2, 0, 0, 0, 0, time.UTC)
+	days := size/8 + 1
+	events := make(models.Events, 0, size)
+
+	for i := 0; i < size; i++ {
+		start := firstDay.Add(time.Duration(random.Intn(days*24*4)) * 15 * time.Minute)
+		end := start.Add(time.Duration(random.Intn(8)+1) * 15 * time.Minute)
+
+		events = append(events, models.Event{
+			ID:       i + 1,
+			Start:    start.Format(LayoutFormat),
+			End:      end.Format(LayoutFormat),
+			Timezone: utcTimeZoneName,
+		})
+	}
+
+	return events
+}
+
+// BenchmarkFindDoubleBookedEventsUC_Handle compare the sweep line against the pairwise scan. The crossover is
+// below two events: the pairwise scan parses every pair and pays one goroutine per event, so the gap grows
+// quadratically with the calendar size, the largest sizes only run the sweep line
+func BenchmarkFindDoubleBookedEventsUC_Handle(b *testing.B) {
+	uc := NewFindDoubleBookedEventsUC()
+
+	for _, size := range []int{2, 4, 8, 16, 64, 256, 1024} {
+		events := generateCalendar(size)
+
+		b.Run(fmt.Sprintf("sweep/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = uc.Handle(events, models.Options{})
+			}
+		})
+
+		b.Run(fmt.Sprintf("pairwise/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = pairwiseDoubleBookedEvents(events)
+			}
+		})
+	}
+
+	for _, size := range []int{10000, 50000} {
+		events := generateCalendar(size)
+
+		b.Run(fmt.Sprintf("sweep/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = uc.Handle(events, models.Options{})
+			}
+		})
+	}
+}
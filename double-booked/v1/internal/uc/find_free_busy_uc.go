@@ -0,0 +1,84 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"time"
+)
+
+// FindFreeBusyUC declaration of use case struct used in this file
+type FindFreeBusyUC struct{}
+
+// Handle merge the events already in UTC in busy blocks and find the free gaps between them inside the
+// window given, only the events that take part in conflicts are busy. The window is also expected in UTC and
+// the result is shown in the timezone of the options
+func (uc *FindFreeBusyUC) Handle(
+	events models.Events,
+	window models.Event,
+	options models.Options,
+) (models.FreeBusyReport, error) {
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.FreeBusyReport{}, err
+	}
+
+	rules, err := resolveParticipation(options)
+	if err != nil {
+		return models.FreeBusyReport{}, err
+	}
+
+	from, to, err := parseWindow(window)
+	if err != nil {
+		return models.FreeBusyReport{}, err
+	}
+
+	parsed, err := parseIntervals(events)
+	if err != nil {
+		return models.FreeBusyReport{}, err
+	}
+
+	intervals := participatingIntervals(parsed, rules)
+	sortIntervals(intervals)
+
+	busy := mergeRanges(intervals, from, to)
+
+	return models.FreeBusyReport{
+		Window: newTimeWindow(from, to, location),
+		Busy:   toTimeWindows(busy, location),
+		Free:   toTimeWindows(freeRanges(busy, from, to), location),
+	}, nil
+}
+
+// parseWindow parse the UTC window given, it must end after it starts
+func parseWindow(window models.Event) (time.Time, time.Time, error) {
+	from, errStart := parseUTCTime(window.Start)
+	to, errEnd := parseUTCTime(window.End)
+
+	if errStart != nil || errEnd != nil || !to.After(from) {
+		return time.Time{}, time.Time{}, &models.EventError{
+			Code:       models.CodeInvalidWindowError,
+			ID:         models.IDDoubleBookedError,
+			Message:    fmt.Sprintf("Invalid window from %s to %s", window.Start, window.End),
+			StatusCode: models.CodeStatusHTTPBusinessError,
+		}
+	}
+
+	return from, to, nil
+}
+
+// toTimeWindows convert the ranges given in windows shown in the location given
+func toTimeWindows(ranges []timeRange, location *time.Location) []models.TimeWindow {
+	windows := make([]models.TimeWindow, 0, len(ranges))
+
+	for _, current := range ranges {
+		windows = append(windows, newTimeWindow(current.start, current.end, location))
+	}
+
+	return windows
+}
+
+// NewFindFreeBusyUC initialize this use case
+func NewFindFreeBusyUC() *FindFreeBusyUC {
+	return &FindFreeBusyUC{}
+}
@@ -0,0 +1,227 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestFindFreeBusyUC_Handle test for this method
+func TestFindFreeBusyUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		events  models.Events
+		window  models.Event
+		options models.Options
+	}
+
+	calendar := models.Events{
+		models.Event{
+			ID:       1,
+			Start:    "2023-02-02 12:00",
+			End:      "2023-02-02 14:00",
+			Timezone: "UTC",
+		},
+		models.Event{
+			ID:       2,
+			Start:    "2023-02-02 13:00",
+			End:      "2023-02-02 15:00",
+			Timezone: "UTC",
+		},
+		models.Event{
+			ID:       3,
+			Start:    "2023-02-02 15:00",
+			End:      "2023-02-02 15:30",
+			Timezone: "UTC",
+		},
+		models.Event{
+			ID:       4,
+			Start:    "2023-02-02 17:00",
+			End:      "2023-02-02 19:00",
+			Timezone: "UTC",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.FreeBusyReport
+		wantErr bool
+	}{
+		{
+			name: "Success with events clipped to the window",
+			args: args{
+				events: calendar,
+				window: models.Event{
+					Start:    "2023-02-02 13:00",
+					End:      "2023-02-02 18:00",
+					Timezone: "UTC",
+				},
+				options: models.Options{Timezone: "Europe/Berlin"},
+			},
+			want: models.FreeBusyReport{
+				Window: models.TimeWindow{
+					Start: "2023-02-02 14:00", End: "2023-02-02 19:00", Timezone: "Europe/Berlin",
+				},
+				Busy: []models.TimeWindow{
+					{Start: "2023-02-02 14:00", End: "2023-02-02 16:30", Timezone: "Europe/Berlin"},
+					{Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: "Europe/Berlin"},
+				},
+				Free: []models.TimeWindow{
+					{Start: "2023-02-02 16:30", End: "2023-02-02 18:00", Timezone: "Europe/Berlin"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without events",
+			args: args{
+				events: models.Events{},
+				window: models.Event{
+					Start:    "2023-02-02 13:00",
+					End:      "2023-02-02 18:00",
+					Timezone: "UTC",
+				},
+			},
+			want: models.FreeBusyReport{
+				Window: models.TimeWindow{
+					Start: "2023-02-02 13:00", End: "2023-02-02 18:00", Timezone: "UTC",
+				},
+				Busy: []models.TimeWindow{},
+				Free: []models.TimeWindow{
+					{Start: "2023-02-02 13:00", End: "2023-02-02 18:00", Timezone: "UTC"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without the events that do not take part in conflicts",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 13:00",
+						End:      "2023-02-02 14:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 14:00",
+						End:      "2023-02-02 15:00",
+						Timezone: "UTC",
+						Status:   models.EventStatusCancelled,
+					},
+					models.Event{
+						ID:           3,
+						Start:        "2023-02-02 15:00",
+						End:          "2023-02-02 16:00",
+						Timezone:     "UTC",
+						Transparency: models.TransparencyTransparent,
+					},
+				},
+				window: models.Event{
+					Start:    "2023-02-02 13:00",
+					End:      "2023-02-02 18:00",
+					Timezone: "UTC",
+				},
+			},
+			want: models.FreeBusyReport{
+				Window: models.TimeWindow{
+					Start: "2023-02-02 13:00", End: "2023-02-02 18:00", Timezone: "UTC",
+				},
+				Busy: []models.TimeWindow{
+					{Start: "2023-02-02 13:00", End: "2023-02-02 14:00", Timezone: "UTC"},
+				},
+				Free: []models.TimeWindow{
+					{Start: "2023-02-02 14:00", End: "2023-02-02 18:00", Timezone: "UTC"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid statuses",
+			args: args{
+				events: calendar,
+				window: models.Event{
+					Start:    "2023-02-02 13:00",
+					End:      "2023-02-02 18:00",
+					Timezone: "UTC",
+				},
+				options: models.Options{Statuses: []models.EventStatus{"WRONG"}},
+			},
+			want:    models.FreeBusyReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by window ending before it starts",
+			args: args{
+				events: calendar,
+				window: models.Event{
+					Start:    "2023-02-02 18:00",
+					End:      "2023-02-02 13:00",
+					Timezone: "UTC",
+				},
+			},
+			want:    models.FreeBusyReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid timezone",
+			args: args{
+				events: calendar,
+				window: models.Event{
+					Start:    "2023-02-02 13:00",
+					End:      "2023-02-02 18:00",
+					Timezone: "UTC",
+				},
+				options: models.Options{Timezone: "WRONG"},
+			},
+			want:    models.FreeBusyReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			uc := &FindFreeBusyUC{}
+			got, err := uc.Handle(tt.args.events, tt.args.window, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewFindFreeBusyUC test for this method
+func TestNewFindFreeBusyUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *FindFreeBusyUC
+	}{
+		{
+			name: "Success",
+			want: NewFindFreeBusyUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewFindFreeBusyUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFindFreeBusyUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
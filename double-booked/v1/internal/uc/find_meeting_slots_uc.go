@@ -0,0 +1,129 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FindMeetingSlotsUC declaration of use case struct used in this file
+type FindMeetingSlotsUC struct{}
+
+// Handle find the first slots of the duration requested inside the window given where every participant is
+// inside their working hours and has no events. The events of the participants and the window are expected
+// in UTC and the slots are shown in the timezone of the options
+func (uc *FindMeetingSlotsUC) Handle(
+	participants models.Participants,
+	window models.Event,
+	slotRequest models.SlotRequest,
+	options models.Options,
+) (models.MeetingSlotsReport, error) {
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.MeetingSlotsReport{}, err
+	}
+
+	from, to, err := parseWindow(window)
+	if err != nil {
+		return models.MeetingSlotsReport{}, err
+	}
+
+	duration, slots, err := resolveSlotRequest(slotRequest)
+	if err != nil {
+		return models.MeetingSlotsReport{}, err
+	}
+
+	rules, err := resolveParticipation(options)
+	if err != nil {
+		return models.MeetingSlotsReport{}, err
+	}
+
+	// Every participant narrows the ranges where the meeting can happen
+	available := []timeRange{{start: from, end: to}}
+
+	for _, participant := range participants {
+		participantRanges, err := availableRanges(participant, rules, from, to)
+		if err != nil {
+			return models.MeetingSlotsReport{}, err
+		}
+
+		available = intersectRanges(available, participantRanges)
+	}
+
+	report := models.MeetingSlotsReport{
+		Slots: []models.TimeWindow{},
+	}
+
+	for _, current := range available {
+		for start := current.start; !start.Add(duration).After(current.end); start = start.Add(duration) {
+			if len(report.Slots) == slots {
+				return report, nil
+			}
+
+			report.Slots = append(report.Slots, newTimeWindow(start, start.Add(duration), location))
+		}
+	}
+
+	return report, nil
+}
+
+// availableRanges build the ranges inside [from, to) where the participant is in working hours and free, only
+// the events that take part in conflicts according to the rules given keep the participant busy
+func availableRanges(participant models.Participant, rules participation, from, to time.Time) ([]timeRange, error) {
+	location := time.UTC
+
+	if participant.Timezone != "" {
+		participantLocation, err := time.LoadLocation(participant.Timezone)
+		if err != nil {
+			return nil, &models.EventError{
+				Code:       models.CodeInvalidOptionsError,
+				ID:         models.IDDoubleBookedError,
+				Message:    fmt.Sprintf("Error setting timezone of participant %s", participant.ID),
+				StatusCode: models.CodeStatusHTTPBusinessError,
+			}
+		}
+
+		location = participantLocation
+	}
+
+	hours, err := parseWorkingHours(participant.WorkingHours)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseIntervals(participant.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := participatingIntervals(parsed, rules)
+	sortIntervals(intervals)
+
+	return subtractRanges(hours.ranges(location, from, to), mergeRanges(intervals, from, to)), nil
+}
+
+// resolveSlotRequest validate the slot request given, the duration is required and one slot is returned
+// when the number of slots is not given
+func resolveSlotRequest(slotRequest models.SlotRequest) (time.Duration, int, error) {
+	if slotRequest.DurationMinutes <= 0 {
+		return 0, 0, invalidOptionError("duration_minutes", strconv.Itoa(slotRequest.DurationMinutes))
+	}
+
+	if slotRequest.Slots < 0 {
+		return 0, 0, invalidOptionError("slots", strconv.Itoa(slotRequest.Slots))
+	}
+
+	slots := slotRequest.Slots
+	if slots == 0 {
+		slots = 1
+	}
+
+	return time.Duration(slotRequest.DurationMinutes) * time.Minute, slots, nil
+}
+
+// NewFindMeetingSlotsUC initialize this use case
+func NewFindMeetingSlotsUC() *FindMeetingSlotsUC {
+	return &FindMeetingSlotsUC{}
+}
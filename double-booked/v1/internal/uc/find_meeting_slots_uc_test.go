@@ -0,0 +1,251 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestFindMeetingSlotsUC_Handle test for this method
+func TestFindMeetingSlotsUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		participants models.Participants
+		window       models.Event
+		slotRequest  models.SlotRequest
+		options      models.Options
+	}
+
+	participants := models.Participants{
+		{
+			ID:           "ana",
+			Timezone:     "America/Bogota",
+			WorkingHours: models.WorkingHours{Start: "09:00", End: "17:00"},
+			Events: models.Events{
+				models.Event{
+					ID:       1,
+					Start:    "2023-02-02 14:00",
+					End:      "2023-02-02 14:30",
+					Timezone: "UTC",
+				},
+			},
+		},
+		{
+			ID:           "max",
+			Timezone:     "Europe/Berlin",
+			WorkingHours: models.WorkingHours{Start: "09:00", End: "17:00"},
+			Events: models.Events{
+				models.Event{
+					ID:       1,
+					Start:    "2023-02-02 15:00",
+					End:      "2023-02-02 15:15",
+					Timezone: "UTC",
+				},
+			},
+		},
+	}
+
+	window := models.Event{
+		Start:    "2023-02-02 00:00",
+		End:      "2023-02-03 00:00",
+		Timezone: "UTC",
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.MeetingSlotsReport
+		wantErr bool
+	}{
+		{
+			name: "Success with slots where everyone is free",
+			args: args{
+				participants: participants,
+				window:       window,
+				slotRequest:  models.SlotRequest{DurationMinutes: 30, Slots: 3},
+			},
+			want: models.MeetingSlotsReport{
+				Slots: []models.TimeWindow{
+					{Start: "2023-02-02 14:30", End: "2023-02-02 15:00", Timezone: "UTC"},
+					{Start: "2023-02-02 15:15", End: "2023-02-02 15:45", Timezone: "UTC"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with one slot by default in the timezone given",
+			args: args{
+				participants: participants,
+				window:       window,
+				slotRequest:  models.SlotRequest{DurationMinutes: 30},
+				options:      models.Options{Timezone: "America/Bogota"},
+			},
+			want: models.MeetingSlotsReport{
+				Slots: []models.TimeWindow{
+					{Start: "2023-02-02 09:30", End: "2023-02-02 10:00", Timezone: "America/Bogota"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with the cancelled events as free time",
+			args: args{
+				participants: models.Participants{
+					{
+						ID:           "ana",
+						Timezone:     "America/Bogota",
+						WorkingHours: models.WorkingHours{Start: "09:00", End: "17:00"},
+						Events: models.Events{
+							models.Event{
+								ID:       1,
+								Start:    "2023-02-02 14:00",
+								End:      "2023-02-02 14:30",
+								Timezone: "UTC",
+								Status:   models.EventStatusCancelled,
+							},
+						},
+					},
+				},
+				window:      window,
+				slotRequest: models.SlotRequest{DurationMinutes: 30},
+			},
+			want: models.MeetingSlotsReport{
+				Slots: []models.TimeWindow{
+					{Start: "2023-02-02 14:00", End: "2023-02-02 14:30", Timezone: "UTC"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with a slot across midnight",
+			args: args{
+				participants: models.Participants{{ID: "ana", Timezone: "UTC"}},
+				window: models.Event{
+					Start:    "2023-02-02 22:30",
+					End:      "2023-02-03 03:00",
+					Timezone: "UTC",
+				},
+				slotRequest: models.SlotRequest{DurationMinutes: 120, Slots: 2},
+			},
+			want: models.MeetingSlotsReport{
+				Slots: []models.TimeWindow{
+					{Start: "2023-02-02 22:30", End: "2023-02-03 00:30", Timezone: "UTC"},
+					{Start: "2023-02-03 00:30", End: "2023-02-03 02:30", Timezone: "UTC"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with a slot longer than a day",
+			args: args{
+				participants: models.Participants{{ID: "ana", Timezone: "America/Bogota"}},
+				window: models.Event{
+					Start:    "2023-02-02 00:00",
+					End:      "2023-02-04 00:00",
+					Timezone: "UTC",
+				},
+				slotRequest: models.SlotRequest{DurationMinutes: 1500},
+			},
+			want: models.MeetingSlotsReport{
+				Slots: []models.TimeWindow{
+					{Start: "2023-02-02 00:00", End: "2023-02-03 01:00", Timezone: "UTC"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without slots out of the working days",
+			args: args{
+				participants: models.Participants{
+					{
+						ID:           "ana",
+						Timezone:     "America/Bogota",
+						WorkingHours: models.WorkingHours{Days: []string{"Friday"}},
+					},
+				},
+				window:      window,
+				slotRequest: models.SlotRequest{DurationMinutes: 30},
+			},
+			want: models.MeetingSlotsReport{
+				Slots: []models.TimeWindow{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid duration",
+			args: args{
+				participants: participants,
+				window:       window,
+				slotRequest:  models.SlotRequest{},
+			},
+			want:    models.MeetingSlotsReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid participant timezone",
+			args: args{
+				participants: models.Participants{{ID: "ana", Timezone: "WRONG"}},
+				window:       window,
+				slotRequest:  models.SlotRequest{DurationMinutes: 30},
+			},
+			want:    models.MeetingSlotsReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid working hours",
+			args: args{
+				participants: models.Participants{
+					{ID: "ana", WorkingHours: models.WorkingHours{Start: "17:00", End: "09:00"}},
+				},
+				window:      window,
+				slotRequest: models.SlotRequest{DurationMinutes: 30},
+			},
+			want:    models.MeetingSlotsReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			uc := &FindMeetingSlotsUC{}
+			got, err := uc.Handle(tt.args.participants, tt.args.window, tt.args.slotRequest, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewFindMeetingSlotsUC test for this method
+func TestNewFindMeetingSlotsUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *FindMeetingSlotsUC
+	}{
+		{
+			name: "Success",
+			want: NewFindMeetingSlotsUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewFindMeetingSlotsUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFindMeetingSlotsUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
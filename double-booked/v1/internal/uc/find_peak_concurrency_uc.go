@@ -0,0 +1,128 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"sort"
+	"time"
+)
+
+// FindPeakConcurrencyUC declaration of use case struct used in this file
+type FindPeakConcurrencyUC struct{}
+
+// boundary instant where the number of events running changes by delta
+type boundary struct {
+	at    time.Time
+	delta int
+}
+
+// concurrencyStep window of time where count events run at the same time
+type concurrencyStep struct {
+	start time.Time
+	end   time.Time
+	count int
+}
+
+// Handle build the step-function timeline of the number of events running at the same time, from the first
+// start to the last end, together with the peak and the windows where it happens. Only the events that take
+// part in conflicts are counted and they are [start, end), so an event ending at the same instant another one
+// starts is never counted twice
+func (uc *FindPeakConcurrencyUC) Handle(
+	events models.Events,
+	options models.Options,
+) (models.ConcurrencyReport, error) {
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.ConcurrencyReport{}, err
+	}
+
+	rules, err := resolveParticipation(options)
+	if err != nil {
+		return models.ConcurrencyReport{}, err
+	}
+
+	parsed, err := parseIntervals(events)
+	if err != nil {
+		return models.ConcurrencyReport{}, err
+	}
+
+	steps := concurrencySteps(participatingIntervals(parsed, rules))
+
+	report := models.ConcurrencyReport{
+		PeakWindows: []models.TimeWindow{},
+		Timeline:    make([]models.ConcurrencyStep, 0, len(steps)),
+	}
+
+	for _, step := range steps {
+		if step.count > report.Peak {
+			report.Peak = step.count
+		}
+	}
+
+	for _, step := range steps {
+		window := newTimeWindow(step.start, step.end, location)
+
+		report.Timeline = append(report.Timeline, models.ConcurrencyStep{
+			Window: window,
+			Count:  step.count,
+		})
+
+		if report.Peak > 0 && step.count == report.Peak {
+			report.PeakWindows = append(report.PeakWindows, window)
+		}
+	}
+
+	return report, nil
+}
+
+// concurrencySteps sweep the boundaries of the intervals in order and build the windows where the count does
+// not change, consecutive windows with the same count are merged
+func concurrencySteps(intervals []interval) []concurrencyStep {
+	boundaries := make([]boundary, 0, len(intervals)*2)
+
+	for _, current := range intervals {
+		if !current.end.After(current.start) {
+			continue
+		}
+
+		boundaries = append(boundaries, boundary{at: current.start, delta: 1}, boundary{at: current.end, delta: -1})
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool {
+		return boundaries[i].at.Before(boundaries[j].at)
+	})
+
+	var steps []concurrencyStep
+
+	count := 0
+
+	for i := 0; i < len(boundaries); {
+		at := boundaries[i].at
+
+		// Every boundary at the same instant is applied before opening the next window
+		for ; i < len(boundaries) && boundaries[i].at.Equal(at); i++ {
+			count += boundaries[i].delta
+		}
+
+		if i == len(boundaries) {
+			break
+		}
+
+		next := boundaries[i].at
+
+		if len(steps) > 0 && steps[len(steps)-1].count == count {
+			steps[len(steps)-1].end = next
+
+			continue
+		}
+
+		steps = append(steps, concurrencyStep{start: at, end: next, count: count})
+	}
+
+	return steps
+}
+
+// NewFindPeakConcurrencyUC initialize this use case
+func NewFindPeakConcurrencyUC() *FindPeakConcurrencyUC {
+	return &FindPeakConcurrencyUC{}
+}
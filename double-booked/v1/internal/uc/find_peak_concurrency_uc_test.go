@@ -0,0 +1,213 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestFindPeakConcurrencyUC_Handle test for this method
+func TestFindPeakConcurrencyUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		events  models.Events
+		options models.Options
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.ConcurrencyReport
+		wantErr bool
+	}{
+		{
+			name: "Success with overlapping and back to back events",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 19:00",
+						End:      "2023-02-02 21:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02 19:30",
+						End:      "2023-02-02 20:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       4,
+						Start:    "2023-02-02 21:00",
+						End:      "2023-02-02 22:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       5,
+						Start:    "2023-02-02 23:00",
+						End:      "2023-02-02 23:30",
+						Timezone: "UTC",
+					},
+				},
+				options: models.Options{Timezone: "America/Bogota"},
+			},
+			want: models.ConcurrencyReport{
+				Peak: 3,
+				PeakWindows: []models.TimeWindow{
+					{Start: "2023-02-02 14:30", End: "2023-02-02 15:00", Timezone: "America/Bogota"},
+				},
+				Timeline: []models.ConcurrencyStep{
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 13:00", End: "2023-02-02 14:00", Timezone: "America/Bogota",
+						},
+						Count: 1,
+					},
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 14:00", End: "2023-02-02 14:30", Timezone: "America/Bogota",
+						},
+						Count: 2,
+					},
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 14:30", End: "2023-02-02 15:00", Timezone: "America/Bogota",
+						},
+						Count: 3,
+					},
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 15:00", End: "2023-02-02 17:00", Timezone: "America/Bogota",
+						},
+						Count: 1,
+					},
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 17:00", End: "2023-02-02 18:00", Timezone: "America/Bogota",
+						},
+						Count: 0,
+					},
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 18:00", End: "2023-02-02 18:30", Timezone: "America/Bogota",
+						},
+						Count: 1,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without the events that do not take part in conflicts",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 18:00",
+						End:      "2023-02-02 19:00",
+						Timezone: "UTC",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02 18:30",
+						End:      "2023-02-02 19:30",
+						Timezone: "UTC",
+						Status:   models.EventStatusCancelled,
+					},
+					models.Event{
+						ID:           3,
+						Start:        "2023-02-02 18:00",
+						End:          "2023-02-02 19:00",
+						Timezone:     "UTC",
+						Transparency: models.TransparencyTransparent,
+					},
+				},
+			},
+			want: models.ConcurrencyReport{
+				Peak: 1,
+				PeakWindows: []models.TimeWindow{
+					{Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: "UTC"},
+				},
+				Timeline: []models.ConcurrencyStep{
+					{
+						Window: models.TimeWindow{
+							Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: "UTC",
+						},
+						Count: 1,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without events",
+			args: args{
+				events: models.Events{},
+			},
+			want: models.ConcurrencyReport{
+				PeakWindows: []models.TimeWindow{},
+				Timeline:    []models.ConcurrencyStep{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid timezone",
+			args: args{
+				events:  models.Events{},
+				options: models.Options{Timezone: "WRONG"},
+			},
+			want:    models.ConcurrencyReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			uc := &FindPeakConcurrencyUC{}
+			got, err := uc.Handle(tt.args.events, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewFindPeakConcurrencyUC test for this method
+func TestNewFindPeakConcurrencyUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *FindPeakConcurrencyUC
+	}{
+		{
+			name: "Success",
+			want: NewFindPeakConcurrencyUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewFindPeakConcurrencyUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewFindPeakConcurrencyUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
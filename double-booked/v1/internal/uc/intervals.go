@@ -0,0 +1,229 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// interval event already normalized to UTC with its parsed start and end and its attendees sorted, the
+// buffers are only set in the intervals padded to find the buffer conflicts
+type interval struct {
+	event        models.Event
+	start        time.Time
+	end          time.Time
+	attendees    []string
+	bufferBefore time.Duration
+	bufferAfter  time.Duration
+}
+
+// parseIntervals parse the UTC events given, an event that can not be parsed is an error instead of an event
+// without conflicts
+func parseIntervals(events models.Events) ([]interval, error) {
+	intervals := make([]interval, 0, len(events))
+
+	for _, event := range events {
+		start, err := parseUTCTime(event.Start)
+		if err != nil {
+			return nil, utcEventError(event)
+		}
+
+		end, err := parseUTCTime(event.End)
+		if err != nil {
+			return nil, utcEventError(event)
+		}
+
+		intervals = append(intervals, interval{
+			event:     event,
+			start:     start,
+			end:       end,
+			attendees: sortedAttendees(event.Attendees),
+		})
+	}
+
+	return intervals, nil
+}
+
+// utcEventError build the error returned when an event expected in UTC can not be parsed
+func utcEventError(event models.Event) error {
+	return &models.EventError{
+		Code:       models.CodeFindDoubleBookedError,
+		ID:         models.IDDoubleBookedError,
+		Message:    fmt.Sprintf("Error parsing timezone of UTC event %v", event),
+		StatusCode: models.CodeStatusHTTPBusinessError,
+	}
+}
+
+// participatingIntervals keep the intervals that take part in conflicts according to the participation rules
+// given, the informational events never do
+func participatingIntervals(intervals []interval, rules participation) []interval {
+	participating := intervals[:0]
+
+	for _, i := range intervals {
+		if i.event.Informational || (i.event.AllDay && rules.excludeAllDay) {
+			continue
+		}
+
+		if !rules.statuses[eventStatus(i.event)] {
+			continue
+		}
+
+		if i.event.Transparency == models.TransparencyTransparent && !rules.includeTransparent {
+			continue
+		}
+
+		participating = append(participating, i)
+	}
+
+	return participating
+}
+
+// eventStatus status of the event given, by default confirmed
+func eventStatus(event models.Event) models.EventStatus {
+	if event.Status == "" {
+		return models.EventStatusConfirmed
+	}
+
+	return event.Status
+}
+
+// validStatus check if the status given is supported, the empty status is confirmed
+func validStatus(status models.EventStatus) bool {
+	switch status {
+	case "", models.EventStatusConfirmed, models.EventStatusTentative, models.EventStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// validTransparency check if the transparency given is supported, the empty transparency is opaque
+func validTransparency(transparency models.Transparency) bool {
+	switch transparency {
+	case "", models.TransparencyOpaque, models.TransparencyTransparent:
+		return true
+	default:
+		return false
+	}
+}
+
+// sortedAttendees sort the attendees given removing the duplicated ones
+func sortedAttendees(attendees []string) []string {
+	if len(attendees) == 0 {
+		return nil
+	}
+
+	sorted := append([]string{}, attendees...)
+	sort.Strings(sorted)
+
+	unique := sorted[:1]
+
+	for _, attendee := range sorted[1:] {
+		if attendee != unique[len(unique)-1] {
+			unique = append(unique, attendee)
+		}
+	}
+
+	return unique
+}
+
+// sharedAttendees return the attendees of both intervals and if they can be double booked, the intervals
+// without attendees keep the previous behaviour and can be double booked with any other interval
+func sharedAttendees(a, b interval) ([]string, bool) {
+	if len(a.attendees) == 0 || len(b.attendees) == 0 {
+		return nil, true
+	}
+
+	var shared []string
+
+	for i, j := 0, 0; i < len(a.attendees) && j < len(b.attendees); {
+		switch {
+		case a.attendees[i] < b.attendees[j]:
+			i++
+		case a.attendees[i] > b.attendees[j]:
+			j++
+		default:
+			shared = append(shared, a.attendees[i])
+			i++
+			j++
+		}
+	}
+
+	return shared, len(shared) > 0
+}
+
+// sortIntervals sort the intervals by start, then by end and finally by event ID
+func sortIntervals(intervals []interval) {
+	sort.Slice(intervals, func(i, j int) bool {
+		if !intervals[i].start.Equal(intervals[j].start) {
+			return intervals[i].start.Before(intervals[j].start)
+		}
+
+		if !intervals[i].end.Equal(intervals[j].end) {
+			return intervals[i].end.Before(intervals[j].end)
+		}
+
+		return intervals[i].event.ID < intervals[j].event.ID
+	})
+}
+
+// overlaps check if two intervals are double booked according to the overlap mode given, it covers every
+// relation between two intervals: partial overlap, containment, identical intervals and shared boundaries
+func overlaps(a, b interval, mode models.OverlapMode) bool {
+	switch mode {
+	case models.OverlapModeClosed:
+		return !a.start.After(b.end) && !b.start.After(a.end)
+	case models.OverlapModeTouchingCounts:
+		if a.start.Equal(a.end) || b.start.Equal(b.end) {
+			return overlaps(a, b, models.OverlapModeHalfOpen)
+		}
+
+		return !a.start.After(b.end) && !b.start.After(a.end)
+	default:
+		return a.start.Before(b.end) && b.start.Before(a.end)
+	}
+}
+
+// hasEnded check if an interval can not overlap the intervals that start at the instant given or later
+func hasEnded(active interval, start time.Time, mode models.OverlapMode) bool {
+	if mode == models.OverlapModeHalfOpen {
+		return !active.end.After(start)
+	}
+
+	return active.end.Before(start)
+}
+
+// newTimeWindow build the window between the instants given shown in the location given
+func newTimeWindow(start, end time.Time, location *time.Location) models.TimeWindow {
+	return models.TimeWindow{
+		Start:    formatTime(start.In(location)),
+		End:      formatTime(end.In(location)),
+		Timezone: location.String(),
+	}
+}
+
+// intervalHeap min-heap of intervals ordered by end, used to keep the active events in the sweep line
+type intervalHeap []interval
+
+// Len implements heap.Interface
+func (h intervalHeap) Len() int { return len(h) }
+
+// Less implements heap.Interface
+func (h intervalHeap) Less(i, j int) bool { return h[i].end.Before(h[j].end) }
+
+// Swap implements heap.Interface
+func (h intervalHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push implements heap.Interface
+func (h *intervalHeap) Push(x interface{}) { *h = append(*h, x.(interval)) }
+
+// Pop implements heap.Interface
+func (h *intervalHeap) Pop() interface{} {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return last
+}
@@ -0,0 +1,201 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestInterval build an interval for the tests with the UTC start and end given
+func newTestInterval(id int, start, end string) interval {
+	startTime, _ := time.Parse(LayoutFormat, start)
+	endTime, _ := time.Parse(LayoutFormat, end)
+
+	return interval{
+		event: models.Event{ID: id, Start: start, End: end, Timezone: utcTimeZoneName},
+		start: startTime,
+		end:   endTime,
+	}
+}
+
+// Test_overlaps test for this method
+func Test_overlaps(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		a interval
+		b interval
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		wantHalfOpen bool
+		wantClosed   bool
+		wantTouching bool
+	}{
+		{
+			name: "Before",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 13:00", "2023-02-02 14:00"),
+				b: newTestInterval(2, "2023-02-02 15:00", "2023-02-02 16:00"),
+			},
+		},
+		{
+			name: "Meets",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 13:00", "2023-02-02 14:00"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantClosed:   true,
+			wantTouching: true,
+		},
+		{
+			name: "Overlaps",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 13:00", "2023-02-02 14:30"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantHalfOpen: true,
+			wantClosed:   true,
+			wantTouching: true,
+		},
+		{
+			name: "Starts",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 14:00", "2023-02-02 14:30"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantHalfOpen: true,
+			wantClosed:   true,
+			wantTouching: true,
+		},
+		{
+			name: "During",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 14:30", "2023-02-02 15:00"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantHalfOpen: true,
+			wantClosed:   true,
+			wantTouching: true,
+		},
+		{
+			name: "Finishes",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 15:00", "2023-02-02 16:00"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantHalfOpen: true,
+			wantClosed:   true,
+			wantTouching: true,
+		},
+		{
+			name: "Equals",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 14:00", "2023-02-02 16:00"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantHalfOpen: true,
+			wantClosed:   true,
+			wantTouching: true,
+		},
+		{
+			name: "Zero length event on the boundary",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 16:00", "2023-02-02 16:00"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantClosed: true,
+		},
+		{
+			name: "Zero length event inside",
+			args: args{
+				a: newTestInterval(1, "2023-02-02 15:00", "2023-02-02 15:00"),
+				b: newTestInterval(2, "2023-02-02 14:00", "2023-02-02 16:00"),
+			},
+			wantHalfOpen: true,
+			wantClosed:   true,
+			wantTouching: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			want := map[models.OverlapMode]bool{
+				models.OverlapModeHalfOpen:       tt.wantHalfOpen,
+				models.OverlapModeClosed:         tt.wantClosed,
+				models.OverlapModeTouchingCounts: tt.wantTouching,
+			}
+
+			for mode, wantOverlap := range want {
+				if got := overlaps(tt.args.a, tt.args.b, mode); got != wantOverlap {
+					t.Errorf("overlaps(a, b, %s) = %v, want %v", mode, got, wantOverlap)
+				}
+
+				if got := overlaps(tt.args.b, tt.args.a, mode); got != wantOverlap {
+					t.Errorf("overlaps(b, a, %s) = %v, want %v", mode, got, wantOverlap)
+				}
+			}
+		})
+	}
+}
+
+// Test_parseIntervals test for this method
+func Test_parseIntervals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		events  models.Events
+		want    []interval
+		wantErr bool
+	}{
+		{
+			name: "Success",
+			events: models.Events{
+				models.Event{ID: 1, Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: utcTimeZoneName},
+			},
+			want:    []interval{newTestInterval(1, "2023-02-02 18:00", "2023-02-02 19:00")},
+			wantErr: false,
+		},
+		{
+			name: "Fail by start date time",
+			events: models.Events{
+				models.Event{ID: 1, Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: utcTimeZoneName},
+				models.Event{ID: 2, Start: "WRONG", End: "2023-02-02 19:00", Timezone: utcTimeZoneName},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Fail by end date time",
+			events: models.Events{
+				models.Event{ID: 1, Start: "2023-02-02 18:00", End: "WRONG", Timezone: utcTimeZoneName},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseIntervals(tt.events)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseIntervals() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseIntervals() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
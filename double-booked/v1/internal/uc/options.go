@@ -0,0 +1,247 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// resolveOverlapMode validate the overlap mode given in the options, when it is empty half_open is used
+func resolveOverlapMode(options models.Options) (models.OverlapMode, error) {
+	switch options.OverlapMode {
+	case "":
+		return models.OverlapModeHalfOpen, nil
+	case models.OverlapModeHalfOpen, models.OverlapModeClosed, models.OverlapModeTouchingCounts:
+		return options.OverlapMode, nil
+	default:
+		return "", invalidOptionError("overlap_mode", string(options.OverlapMode))
+	}
+}
+
+// resolveSortOrder validate the sort order given in the options, when it is empty the pairs are sorted by ID
+func resolveSortOrder(options models.Options) (models.SortOrder, error) {
+	switch options.Sort {
+	case "":
+		return models.SortByID, nil
+	case models.SortByID, models.SortByStart, models.SortByDuration:
+		return options.Sort, nil
+	default:
+		return "", invalidOptionError("sort", string(options.Sort))
+	}
+}
+
+// resolveLocation load the timezone given in the options used to show local times, by default UTC
+func resolveLocation(options models.Options) (*time.Location, error) {
+	if options.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(options.Timezone)
+	if err != nil {
+		return nil, invalidOptionError("timezone", options.Timezone)
+	}
+
+	return location, nil
+}
+
+// resolveBuffers parse the default buffers given in the options, by default there are no buffers
+func resolveBuffers(options models.Options) (time.Duration, time.Duration, error) {
+	before, err := parseBuffer(options.BufferBefore)
+	if err != nil {
+		return 0, 0, invalidOptionError("buffer_before", options.BufferBefore)
+	}
+
+	after, err := parseBuffer(options.BufferAfter)
+	if err != nil {
+		return 0, 0, invalidOptionError("buffer_after", options.BufferAfter)
+	}
+
+	return before, after, nil
+}
+
+// tolerance overlaps ignored, up to a duration or up to a percentage of the shorter event, zero disables them
+type tolerance struct {
+	duration time.Duration
+	percent  float64
+}
+
+// resolveTolerance validate the tolerance given in the options, by default every overlap is double booked
+func resolveTolerance(options models.Options) (tolerance, error) {
+	if options.ToleranceMinutes < 0 {
+		return tolerance{}, invalidOptionError("tolerance_minutes", strconv.Itoa(options.ToleranceMinutes))
+	}
+
+	if options.TolerancePercent < 0 || options.TolerancePercent > 100 {
+		return tolerance{}, invalidOptionError("tolerance_percent", formatPercent(options.TolerancePercent))
+	}
+
+	return tolerance{
+		duration: time.Duration(options.ToleranceMinutes) * time.Minute,
+		percent:  options.TolerancePercent,
+	}, nil
+}
+
+// resolveSeverityThresholds validate the thresholds given in the options, the missing ones take the defaults
+func resolveSeverityThresholds(options models.Options) (models.SeverityThresholds, error) {
+	thresholds := options.SeverityThresholds
+
+	if thresholds.WarningPercent == 0 {
+		thresholds.WarningPercent = defaultWarningPercent
+	}
+
+	if thresholds.CriticalPercent == 0 {
+		thresholds.CriticalPercent = defaultCriticalPercent
+	}
+
+	if thresholds.WarningPercent < 0 || thresholds.WarningPercent > thresholds.CriticalPercent {
+		return models.SeverityThresholds{}, invalidOptionError(
+			"severity_thresholds.warning_percent",
+			formatPercent(thresholds.WarningPercent),
+		)
+	}
+
+	if thresholds.CriticalPercent > 100 {
+		return models.SeverityThresholds{}, invalidOptionError(
+			"severity_thresholds.critical_percent",
+			formatPercent(thresholds.CriticalPercent),
+		)
+	}
+
+	return thresholds, nil
+}
+
+// resolveMinSeverity validate the minimum severity given in the options, by default every conflict is reported
+func resolveMinSeverity(options models.Options) (models.Severity, error) {
+	switch options.MinSeverity {
+	case "":
+		return models.SeverityInfo, nil
+	case models.SeverityInfo, models.SeverityWarning, models.SeverityCritical:
+		return options.MinSeverity, nil
+	default:
+		return "", invalidOptionError("min_severity", string(options.MinSeverity))
+	}
+}
+
+// formatPercent format a percentage given in the options for the error messages
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', -1, 64)
+}
+
+// participation rules that decide which events take part in conflicts
+type participation struct {
+	statuses           map[models.EventStatus]bool
+	includeTransparent bool
+	excludeAllDay      bool
+}
+
+// resolveParticipation validate the statuses given in the options, by default the confirmed and tentative
+// events take part in conflicts
+func resolveParticipation(options models.Options) (participation, error) {
+	rules := participation{
+		statuses:           map[models.EventStatus]bool{},
+		includeTransparent: options.IncludeTransparent,
+		excludeAllDay:      options.ExcludeAllDay,
+	}
+
+	statuses := options.Statuses
+	if len(statuses) == 0 {
+		statuses = []models.EventStatus{models.EventStatusConfirmed, models.EventStatusTentative}
+	}
+
+	for _, status := range statuses {
+		if !validStatus(status) || status == "" {
+			return participation{}, invalidOptionError("statuses", string(status))
+		}
+
+		rules.statuses[status] = true
+	}
+
+	return rules, nil
+}
+
+// horizon window where the recurring events are expanded, the zero instants are resolved for each series
+type horizon struct {
+	start time.Time
+	end   time.Time
+}
+
+// resolveHorizon parse the horizon given in the options in the same formats as the events, the bounds without
+// UTC offset are in the timezone of the options
+func resolveHorizon(options models.Options) (horizon, error) {
+	location, err := resolveLocation(options)
+	if err != nil {
+		return horizon{}, err
+	}
+
+	var (
+		bounds horizon
+		ok     bool
+	)
+
+	if options.HorizonStart != "" {
+		bounds.start, ok = parseHorizonBound(options.HorizonStart, location)
+		if !ok {
+			return horizon{}, invalidOptionError("horizon_start", options.HorizonStart)
+		}
+	}
+
+	if options.HorizonEnd != "" {
+		bounds.end, ok = parseHorizonBound(options.HorizonEnd, location)
+		if !ok || (!bounds.start.IsZero() && !bounds.end.After(bounds.start)) {
+			return horizon{}, invalidOptionError("horizon_end", options.HorizonEnd)
+		}
+	}
+
+	return bounds, nil
+}
+
+// parseHorizonBound parse a bound of the horizon, with its UTC offset or as a wall clock time or a date, which
+// starts at midnight, in the location given
+func parseHorizonBound(value string, location *time.Location) (time.Time, bool) {
+	if dateTime, ok := parseOffsetTime(value); ok {
+		return dateTime, true
+	}
+
+	wallClock, ok := parseWallClockTime(value)
+	if !ok {
+		date, err := time.Parse(DateLayoutFormat, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		wallClock = date
+	}
+
+	return time.Date(
+		wallClock.Year(), wallClock.Month(), wallClock.Day(),
+		wallClock.Hour(), wallClock.Minute(), wallClock.Second(), 0, location,
+	), true
+}
+
+// window resolve the horizon for a series starting at the instant given, by default it starts with the series
+// and lasts defaultHorizonYears years
+func (h horizon) window(seriesStart time.Time) (time.Time, time.Time) {
+	start := h.start
+	if start.IsZero() {
+		start = seriesStart
+	}
+
+	end := h.end
+	if end.IsZero() {
+		end = start.AddDate(defaultHorizonYears, 0, 0)
+	}
+
+	return start, end
+}
+
+// invalidOptionError build the error returned when an option of the request has a value not supported
+func invalidOptionError(option, value string) error {
+	return &models.EventError{
+		Code:       models.CodeInvalidOptionsError,
+		ID:         models.IDDoubleBookedError,
+		Message:    fmt.Sprintf("Invalid value %q for option %s", value, option),
+		StatusCode: models.CodeStatusHTTPBusinessError,
+	}
+}
@@ -0,0 +1,252 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"testing"
+	"time"
+)
+
+// Test_resolveOverlapMode test for this method
+func Test_resolveOverlapMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options models.Options
+		want    models.OverlapMode
+		wantErr bool
+	}{
+		{
+			name:    "Default half open",
+			options: models.Options{},
+			want:    models.OverlapModeHalfOpen,
+		},
+		{
+			name:    "Closed",
+			options: models.Options{OverlapMode: models.OverlapModeClosed},
+			want:    models.OverlapModeClosed,
+		},
+		{
+			name:    "Invalid mode",
+			options: models.Options{OverlapMode: "WRONG"},
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveOverlapMode(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveOverlapMode() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("resolveOverlapMode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_resolveSortOrder test for this method
+func Test_resolveSortOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options models.Options
+		want    models.SortOrder
+		wantErr bool
+	}{
+		{
+			name:    "Default by ID",
+			options: models.Options{},
+			want:    models.SortByID,
+		},
+		{
+			name:    "By duration",
+			options: models.Options{Sort: models.SortByDuration},
+			want:    models.SortByDuration,
+		},
+		{
+			name:    "Invalid sort",
+			options: models.Options{Sort: "WRONG"},
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveSortOrder(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveSortOrder() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("resolveSortOrder() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_resolveSeverityThresholds test for this method
+func Test_resolveSeverityThresholds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options models.Options
+		want    models.SeverityThresholds
+		wantErr bool
+	}{
+		{
+			name:    "Default thresholds",
+			options: models.Options{},
+			want:    models.SeverityThresholds{WarningPercent: 25, CriticalPercent: 75},
+		},
+		{
+			name:    "Missing thresholds take the defaults",
+			options: models.Options{SeverityThresholds: models.SeverityThresholds{WarningPercent: 10}},
+			want:    models.SeverityThresholds{WarningPercent: 10, CriticalPercent: 75},
+		},
+		{
+			name:    "Warning above critical",
+			options: models.Options{SeverityThresholds: models.SeverityThresholds{WarningPercent: 80}},
+			wantErr: true,
+		},
+		{
+			name:    "Critical above one hundred",
+			options: models.Options{SeverityThresholds: models.SeverityThresholds{CriticalPercent: 120}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveSeverityThresholds(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveSeverityThresholds() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if got != tt.want {
+				t.Errorf("resolveSeverityThresholds() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_resolveTolerance test for this method
+func Test_resolveTolerance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		options models.Options
+		wantErr bool
+	}{
+		{name: "Without tolerance", options: models.Options{}},
+		{name: "Absolute and percentage", options: models.Options{ToleranceMinutes: 5, TolerancePercent: 10}},
+		{name: "Negative minutes", options: models.Options{ToleranceMinutes: -1}, wantErr: true},
+		{name: "Percentage above one hundred", options: models.Options{TolerancePercent: 101}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := resolveTolerance(tt.options); (err != nil) != tt.wantErr {
+				t.Errorf("resolveTolerance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Test_resolveHorizon test for this method
+func Test_resolveHorizon(t *testing.T) {
+	t.Parallel()
+
+	bogota, _ := time.LoadLocation("America/Bogota")
+
+	tests := []struct {
+		name    string
+		options models.Options
+		want    horizon
+		wantErr bool
+	}{
+		{name: "Without horizon", options: models.Options{}, want: horizon{}},
+		{
+			name: "Wall clock times in the timezone of the options",
+			options: models.Options{
+				Timezone:     "America/Bogota",
+				HorizonStart: "2023-02-01T08:00",
+				HorizonEnd:   "2023-03-01 08:00:30",
+			},
+			want: horizon{
+				start: time.Date(2023, 2, 1, 8, 0, 0, 0, bogota),
+				end:   time.Date(2023, 3, 1, 8, 0, 30, 0, bogota),
+			},
+		},
+		{
+			name: "Date times with their UTC offset",
+			options: models.Options{
+				Timezone:     "America/Bogota",
+				HorizonStart: "2023-02-01T08:00:00Z",
+				HorizonEnd:   "2023-03-01T08:00:00+01:00",
+			},
+			want: horizon{
+				start: time.Date(2023, 2, 1, 8, 0, 0, 0, time.UTC),
+				end:   time.Date(2023, 3, 1, 7, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Dates in the timezone of the options",
+			options: models.Options{
+				Timezone:     "America/Bogota",
+				HorizonStart: "2023-02-01",
+				HorizonEnd:   "2023-03-01",
+			},
+			want: horizon{
+				start: time.Date(2023, 2, 1, 0, 0, 0, 0, bogota),
+				end:   time.Date(2023, 3, 1, 0, 0, 0, 0, bogota),
+			},
+		},
+		{name: "Invalid start", options: models.Options{HorizonStart: "WRONG"}, wantErr: true},
+		{
+			name:    "End before the start",
+			options: models.Options{HorizonStart: "2023-03-01 08:00", HorizonEnd: "2023-02-01 08:00"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveHorizon(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveHorizon() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !got.start.Equal(tt.want.start) || !got.end.Equal(tt.want.end) {
+				t.Errorf("resolveHorizon() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,27 +3,47 @@ package uc
 
 import (
 	"LiteraTest/double-booked/v1/internal/models"
+	"errors"
 	"fmt"
 	"time"
 )
 
 const (
-	LayoutFormat    = "2006-01-02 15:04"
-	utcTimeZoneName = "UTC"
+	LayoutFormat        = "2006-01-02 15:04"
+	SecondsLayoutFormat = "2006-01-02 15:04:05"
+	DateLayoutFormat    = "2006-01-02"
+	utcTimeZoneName     = "UTC"
 )
 
 // ParseEventsToUTCUC declaration of use case struct used in this file
 type ParseEventsToUTCUC struct{}
 
-// Handle this use case will convert the timezone of each event to UTC to standardize the process
-func (uc *ParseEventsToUTCUC) Handle(events models.Events) (models.Events, error) {
-	var eventsInUTC models.Events
+// Handle this use case will convert the timezone of each event to UTC to standardize the process.
+// The date times given with their UTC offset keep their instant and the seconds are kept in the UTC events.
+// The recurring events are expanded in their own timezone inside the horizon of the options before the
+// conversion, so every occurrence is returned as a separate event. The local times in a DST gap or fold are
+// resolved with the DST policy of the options and returned as warnings
+func (uc *ParseEventsToUTCUC) Handle(events models.Events, options models.Options) (models.Events, models.Warnings, error) {
+	var (
+		eventsInUTC models.Events
+		warnings    models.Warnings
+	)
+
+	bounds, err := resolveHorizon(options)
+	if err != nil {
+		return models.Events{}, nil, err
+	}
+
+	policy, err := resolveDSTPolicy(options)
+	if err != nil {
+		return models.Events{}, nil, err
+	}
 
 	for _, event := range events {
-		// Original location to get in mind
-		location, err := time.LoadLocation(event.Timezone)
+		// Original locations to get in mind, the start and the end can be in different timezones
+		location, err := eventLocation(event)
 		if err != nil {
-			return models.Events{}, &models.EventError{
+			return models.Events{}, nil, &models.EventError{
 				Code:       models.CodeParseEventError,
 				ID:         models.IDDoubleBookedError,
 				Message:    fmt.Sprintf("Error setting timezone of event %v", event),
@@ -31,28 +51,56 @@ func (uc *ParseEventsToUTCUC) Handle(events models.Events) (models.Events, error
 			}
 		}
 
-		// Start date conversion
-		startDateTimeString := event.Start
+		endLocation, err := eventEndLocation(event, location)
+		if err != nil {
+			return models.Events{}, nil, &models.EventError{
+				Code:       models.CodeParseEventError,
+				ID:         models.IDDoubleBookedError,
+				Message:    fmt.Sprintf("Error setting timezone of event %v", event),
+				StatusCode: models.CodeStatusHTTPBusinessError,
+			}
+		}
 
-		originalStartDateTime, err := time.ParseInLocation(LayoutFormat, startDateTimeString, location)
+		if !validStatus(event.Status) || !validTransparency(event.Transparency) {
+			return models.Events{}, nil, &models.EventError{
+				Code:       models.CodeParseEventError,
+				ID:         models.IDDoubleBookedError,
+				Message:    fmt.Sprintf("Error parsing status of event %v", event),
+				StatusCode: models.CodeStatusHTTPBusinessError,
+			}
+		}
+
+		if event.Priority < 0 {
+			return models.Events{}, nil, &models.EventError{
+				Code:       models.CodeParseEventError,
+				ID:         models.IDDoubleBookedError,
+				Message:    fmt.Sprintf("Error parsing priority of event %v", event),
+				StatusCode: models.CodeStatusHTTPBusinessError,
+			}
+		}
+
+		length, err := parseEventDuration(event)
 		if err != nil {
-			return models.Events{}, &models.EventError{
+			return models.Events{}, nil, &models.EventError{
 				Code:       models.CodeParseEventError,
 				ID:         models.IDDoubleBookedError,
-				Message:    fmt.Sprintf("Error parsing timezone of event %v", event),
+				Message:    fmt.Sprintf("Error parsing duration of event %v: %s", event, err.Error()),
 				StatusCode: models.CodeStatusHTTPBusinessError,
 			}
 		}
 
-		startDateTimeInUTC := originalStartDateTime.UTC()
-		startDateTimeInUTCString := startDateTimeInUTC.Format(LayoutFormat)
+		// Start and end conversion, all-day events are given as dates and last until the end of their end date
+		resolver := &dstResolver{policy: policy}
 
-		// End date conversion
-		endDateTimeString := event.End
+		eventRange, allDay, err := parseEventRange(event, length, location, endLocation, resolver)
+
+		var rejected *dstError
+		if errors.As(err, &rejected) {
+			return models.Events{}, nil, dstRejectedError(event, rejected)
+		}
 
-		originalEndDateTime, err := time.ParseInLocation(LayoutFormat, endDateTimeString, location)
 		if err != nil {
-			return models.Events{}, &models.EventError{
+			return models.Events{}, nil, &models.EventError{
 				Code:       models.CodeParseEventError,
 				ID:         models.IDDoubleBookedError,
 				Message:    fmt.Sprintf("Error parsing timezone of event %v", event),
@@ -60,19 +108,259 @@ func (uc *ParseEventsToUTCUC) Handle(events models.Events) (models.Events, error
 			}
 		}
 
-		endDateTimeInUTC := originalEndDateTime.UTC()
-		endDateTimeInUTCString := endDateTimeInUTC.Format(LayoutFormat)
+		// The instants of both ends are compared, so an end in another timezone can not fall before the start
+		if eventRange.end.Before(eventRange.start) {
+			return models.Events{}, nil, &models.EventError{
+				Code:       models.CodeParseEventError,
+				ID:         models.IDDoubleBookedError,
+				Message:    fmt.Sprintf("Error parsing end of event %v, it ends before it starts", event),
+				StatusCode: models.CodeStatusHTTPBusinessError,
+			}
+		}
+
+		// Both fields are only set by the conversion, the occurrence start comes from the expansion of the series
+		event.AllDay = allDay
+		event.OccurrenceStart = ""
+
+		if event.RRule == "" {
+			eventsInUTC = append(eventsInUTC, toUTCEvent(event, eventRange.start, eventRange.end))
+			warnings = append(warnings, resolver.warnings(event)...)
+
+			continue
+		}
+
+		// Every occurrence warns about its own start, the first one included
+		resolver.resolved = nil
+
+		occurrences, err := expandOccurrences(event, eventRange, length, bounds, resolver)
+		if err != nil {
+			return models.Events{}, nil, err
+		}
+
+		eventsInUTC = append(eventsInUTC, occurrences...)
+		warnings = append(warnings, resolver.warnings(event)...)
+	}
+
+	return eventsInUTC, warnings, nil
+}
 
-		// Append to new list
-		eventsInUTC = append(eventsInUTC, models.Event{
-			ID:       event.ID,
-			Start:    startDateTimeInUTCString,
-			End:      endDateTimeInUTCString,
-			Timezone: utcTimeZoneName,
-		})
+// expandOccurrences expand the recurring event given in one UTC event per occurrence inside the horizon, the
+// cancelled occurrences are skipped and the moved ones keep their original start to identify them. The events
+// given with a duration apply it to the start of every occurrence
+func expandOccurrences(
+	event models.Event,
+	first timeRange,
+	length isoDuration,
+	bounds horizon,
+	resolver *dstResolver,
+) (models.Events, error) {
+	start := first.start
+
+	rule, err := parseRecurrenceRule(event.RRule, start.Location())
+	if err != nil {
+		return nil, recurrenceError(event, err)
+	}
+
+	exceptions, err := parseRecurrenceExceptions(event, start.Location(), first.end.Location(), resolver)
+	if err != nil {
+		return nil, recurrenceError(event, err)
 	}
 
-	return eventsInUTC, nil
+	from, to := bounds.window(start)
+	duration := first.end.Sub(start)
+	days := daysBetween(start, first.end)
+
+	starts, err := rule.occurrences(start, duration, from, to)
+	if err != nil {
+		return nil, recurrenceError(event, err)
+	}
+
+	occurrences := make(models.Events, 0, len(starts))
+	hour, minute, second := start.Clock()
+
+	for _, generated := range starts {
+		// The occurrences keep the wall clock time of the series, the ones in a DST gap or fold follow the policy
+		wallClock := seriesWallClock(generated, hour, minute, second)
+
+		occurrenceID := formatTime(wallClock)
+		if event.AllDay {
+			occurrenceID = wallClock.Format(DateLayoutFormat)
+		}
+
+		occurrenceStart, err := resolver.resolve(wallClock, start.Location(), occurrenceID)
+
+		var rejected *dstError
+		if errors.As(err, &rejected) {
+			return nil, dstRejectedError(event, rejected)
+		}
+
+		if exceptions.cancelled[occurrenceStart.Unix()] {
+			continue
+		}
+
+		// The all-day occurrences last whole days even when a DST change makes them shorter or longer
+		occurrenceRange := timeRange{start: occurrenceStart, end: occurrenceStart.Add(duration)}
+
+		switch {
+		case event.Duration != "":
+			occurrenceRange.end, err = length.after(occurrenceStart, resolver, occurrenceID)
+			if errors.As(err, &rejected) {
+				return nil, dstRejectedError(event, rejected)
+			}
+		case event.AllDay:
+			occurrenceRange.end = occurrenceStart.AddDate(0, 0, days)
+		}
+
+		if moved, ok := exceptions.moved[occurrenceStart.Unix()]; ok {
+			occurrenceRange = moved
+		}
+
+		occurrence := toUTCEvent(event, occurrenceRange.start, occurrenceRange.end)
+		occurrence.RRule = ""
+		occurrence.ExDates = nil
+		occurrence.Overrides = nil
+		occurrence.OccurrenceStart = occurrenceID
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences, nil
+}
+
+// eventLocation location of the start of the event given, its start timezone or else its timezone. An event
+// without them is in the UTC offset of its start when it is given with one and in UTC otherwise
+func eventLocation(event models.Event) (*time.Location, error) {
+	timezone := event.Timezone
+	if event.StartTimezone != "" {
+		timezone = event.StartTimezone
+	}
+
+	if timezone == "" {
+		if start, ok := parseOffsetTime(event.Start); ok {
+			return start.Location(), nil
+		}
+	}
+
+	return time.LoadLocation(timezone)
+}
+
+// eventEndLocation location of the end of the event given, its end timezone or else its timezone. An event
+// without them ends in the location of its start
+func eventEndLocation(event models.Event, location *time.Location) (*time.Location, error) {
+	switch {
+	case event.EndTimezone != "":
+		return time.LoadLocation(event.EndTimezone)
+	case event.Timezone != "":
+		return time.LoadLocation(event.Timezone)
+	default:
+		return location, nil
+	}
+}
+
+// parseEventDuration parse the duration of the event given, an event must give either its end or its duration
+func parseEventDuration(event models.Event) (isoDuration, error) {
+	switch {
+	case event.End != "" && event.Duration != "":
+		return isoDuration{}, errors.New("the end and the duration can not be given together")
+	case event.End == "" && event.Duration == "":
+		return isoDuration{}, errors.New("the end or the duration must be given")
+	case event.Duration == "":
+		return isoDuration{}, nil
+	}
+
+	return parseISODuration(event.Duration)
+}
+
+// parseEventRange parse the range of the event given in the locations given, it ends at its end or after its
+// duration. The duration is counted in the location of the start and the duration of an all-day event must be
+// whole days, so it ends at a midnight
+func parseEventRange(
+	event models.Event,
+	length isoDuration,
+	location, endLocation *time.Location,
+	resolver *dstResolver,
+) (timeRange, bool, error) {
+	if event.Duration == "" {
+		return parseLocalRange(event.Start, event.End, location, endLocation, resolver)
+	}
+
+	start, allDay, err := parseLocalTime(event.Start, location, resolver)
+	if err != nil {
+		return timeRange{}, false, err
+	}
+
+	if allDay && !length.wholeDays() {
+		return timeRange{}, false, fmt.Errorf("duration %q of an all-day event must be whole days", event.Duration)
+	}
+
+	end, err := length.after(start, resolver, "")
+
+	return timeRange{start: start, end: end}, allDay, err
+}
+
+// parseLocalRange parse the start and end given in their locations, both of them are date times or, for the
+// all-day events, dates. The end date of an all-day event is included, so the event lasts until the next midnight
+func parseLocalRange(
+	start, end string,
+	startLocation, endLocation *time.Location,
+	resolver *dstResolver,
+) (timeRange, bool, error) {
+	startDateTime, startAllDay, err := parseLocalTime(start, startLocation, resolver)
+	if err != nil {
+		return timeRange{}, false, err
+	}
+
+	endDateTime, endAllDay, err := parseLocalTime(end, endLocation, resolver)
+	if err != nil {
+		return timeRange{}, false, err
+	}
+
+	if startAllDay != endAllDay {
+		return timeRange{}, false, fmt.Errorf("start %q and end %q must both be dates or date times", start, end)
+	}
+
+	if startAllDay {
+		endDateTime = endDateTime.AddDate(0, 0, 1)
+	}
+
+	return timeRange{start: startDateTime, end: endDateTime}, startAllDay, nil
+}
+
+// parseLocalTime parse a date time or a date in the location given, it returns if a date was given. The date
+// times given with their UTC offset keep their instant and are moved to the location given, the rest are
+// resolved in the location given, so the ones in a DST gap or fold follow the policy of the resolver
+func parseLocalTime(value string, location *time.Location, resolver *dstResolver) (time.Time, bool, error) {
+	if dateTime, ok := parseOffsetTime(value); ok {
+		return dateTime.In(location), false, nil
+	}
+
+	if wallClock, ok := parseWallClockTime(value); ok {
+		dateTime, err := resolver.resolve(wallClock, location, "")
+
+		return dateTime, false, err
+	}
+
+	date, err := time.Parse(DateLayoutFormat, value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	dateTime, err := resolver.resolve(date, location, "")
+
+	return dateTime, true, err
+}
+
+// toUTCEvent copy the event given with its start and end in UTC keeping the rest of the fields of the event
+func toUTCEvent(event models.Event, start, end time.Time) models.Event {
+	eventInUTC := event
+	eventInUTC.Start = formatTime(start.UTC())
+	eventInUTC.End = formatTime(end.UTC())
+	eventInUTC.Duration = ""
+	eventInUTC.Timezone = utcTimeZoneName
+	eventInUTC.StartTimezone = ""
+	eventInUTC.EndTimezone = ""
+
+	return eventInUTC
 }
 
 // NewParseEventsToUTCUC initialize this use case
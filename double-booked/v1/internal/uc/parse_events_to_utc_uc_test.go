@@ -37,7 +37,8 @@ func TestParseEventsToUTCUC_Handle(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		events models.Events
+		events  models.Events
+		options models.Options
 	}
 
 	tests := []struct {
@@ -80,6 +81,561 @@ func TestParseEventsToUTCUC_Handle(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Success keeping the attendees",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:        1,
+						Start:     "2023-02-02 13:00",
+						End:       "2023-02-02 14:00",
+						Timezone:  "America/Bogota",
+						Attendees: []string{"ana", "max"},
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:        1,
+					Start:     "2023-02-02 18:00",
+					End:       "2023-02-02 19:00",
+					Timezone:  "UTC",
+					Attendees: []string{"ana", "max"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success ignoring the occurrence start and all-day flag given in the request",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:              1,
+						Start:           "2023-02-02 13:00",
+						End:             "2023-02-02 14:00",
+						Timezone:        "America/Bogota",
+						OccurrenceStart: "2023-02-02 13:00",
+						AllDay:          true,
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:       1,
+					Start:    "2023-02-02 18:00",
+					End:      "2023-02-02 19:00",
+					Timezone: "UTC",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success expanding a recurring event following the DST change of its timezone",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-03-08 09:00",
+						End:      "2023-03-08 10:00",
+						Timezone: "America/New_York",
+						RRule:    "FREQ=WEEKLY;COUNT=2",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              1,
+					Start:           "2023-03-08 14:00",
+					End:             "2023-03-08 15:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-03-08 09:00",
+				},
+				models.Event{
+					ID:              1,
+					Start:           "2023-03-15 13:00",
+					End:             "2023-03-15 14:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-03-15 09:00",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success expanding a recurring event inside the horizon",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+						RRule:    "FREQ=DAILY",
+					},
+				},
+				options: models.Options{
+					HorizonStart: "2023-02-03 00:00",
+					HorizonEnd:   "2023-02-05 00:00",
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              1,
+					Start:           "2023-02-03 18:00",
+					End:             "2023-02-03 19:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-02-03 13:00",
+				},
+				models.Event{
+					ID:              1,
+					Start:           "2023-02-04 18:00",
+					End:             "2023-02-04 19:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-02-04 13:00",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success cancelling and moving occurrences of a recurring event",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+						RRule:    "FREQ=DAILY;COUNT=3",
+						ExDates:  []string{"2023-02-02 13:00"},
+						Overrides: models.Overrides{
+							{
+								OccurrenceStart: "2023-02-03 13:00",
+								Start:           "2023-02-03 20:00",
+								End:             "2023-02-03 20:30",
+								Timezone:        "UTC",
+							},
+						},
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              1,
+					Start:           "2023-02-01 18:00",
+					End:             "2023-02-01 19:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-02-01 13:00",
+				},
+				models.Event{
+					ID:              1,
+					Start:           "2023-02-03 20:00",
+					End:             "2023-02-03 20:30",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-02-03 13:00",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error parsing an override of a recurring event",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+						RRule:    "FREQ=DAILY;COUNT=3",
+						Overrides: models.Overrides{
+							{OccurrenceStart: "2023-02-03 13:00", Start: "2023-02-03 15:00", End: "WRONG"},
+						},
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Success with all-day events in the timezone of the event",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01",
+						End:      "2023-02-03",
+						Timezone: "America/Bogota",
+					},
+					models.Event{
+						ID:            2,
+						Start:         "2023-02-02",
+						End:           "2023-02-02",
+						Timezone:      "America/Bogota",
+						Informational: true,
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:       1,
+					Start:    "2023-02-01 05:00",
+					End:      "2023-02-04 05:00",
+					Timezone: "UTC",
+					AllDay:   true,
+				},
+				models.Event{
+					ID:            2,
+					Start:         "2023-02-02 05:00",
+					End:           "2023-02-03 05:00",
+					Timezone:      "UTC",
+					AllDay:        true,
+					Informational: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success expanding a recurring all-day event",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-03-11",
+						End:      "2023-03-11",
+						Timezone: "America/New_York",
+						RRule:    "FREQ=DAILY;COUNT=2",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              1,
+					Start:           "2023-03-11 05:00",
+					End:             "2023-03-12 05:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-03-11",
+					AllDay:          true,
+				},
+				models.Event{
+					ID:              1,
+					Start:           "2023-03-12 05:00",
+					End:             "2023-03-13 04:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-03-12",
+					AllDay:          true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with RFC 3339 offsets and seconds",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:    1,
+						Start: "2023-02-02T13:00:00-05:00",
+						End:   "2023-02-02T14:00:30.250-05:00",
+					},
+					models.Event{
+						ID:       2,
+						Start:    "2023-02-02T13:00-0500",
+						End:      "2023-02-02T19:00Z",
+						Timezone: "Europe/Madrid",
+					},
+					models.Event{
+						ID:       3,
+						Start:    "2023-02-02T13:00:15",
+						End:      "2023-02-02 14:00:15",
+						Timezone: "America/Bogota",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:       1,
+					Start:    "2023-02-02 18:00",
+					End:      "2023-02-02 19:00:30",
+					Timezone: "UTC",
+				},
+				models.Event{
+					ID:       2,
+					Start:    "2023-02-02 18:00",
+					End:      "2023-02-02 19:00",
+					Timezone: "UTC",
+				},
+				models.Event{
+					ID:       3,
+					Start:    "2023-02-02 18:00:15",
+					End:      "2023-02-02 19:00:15",
+					Timezone: "UTC",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with recurring event in the offset of its start",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:    1,
+						Start: "2023-02-02T13:00:00-05:00",
+						End:   "2023-02-02T14:00:00-05:00",
+						RRule: "FREQ=DAILY;COUNT=2",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              1,
+					Start:           "2023-02-02 18:00",
+					End:             "2023-02-02 19:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-02-02 13:00",
+				},
+				models.Event{
+					ID:              1,
+					Start:           "2023-02-03 18:00",
+					End:             "2023-02-03 19:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-02-03 13:00",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error with a status not supported",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+						Status:   "WRONG",
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error with a negative priority",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+						Priority: -1,
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error mixing a date and a date time",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error expanding a recurrence rule not supported",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+						RRule:    "FREQ=HOURLY",
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Success with a duration instead of the end",
+			args: args{
+				events: models.Events{
+					models.Event{ID: 1, Start: "2023-02-02 13:00", Duration: "PT45M", Timezone: "America/Bogota"},
+					models.Event{ID: 2, Start: "2023-02-01", Duration: "P2D", Timezone: "America/Bogota"},
+				},
+			},
+			want: models.Events{
+				models.Event{ID: 1, Start: "2023-02-02 18:00", End: "2023-02-02 18:45", Timezone: "UTC"},
+				models.Event{ID: 2, Start: "2023-02-01 05:00", End: "2023-02-03 05:00", Timezone: "UTC", AllDay: true},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with a duration in days across the DST change of its timezone",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-03-04 09:00",
+						Duration: "P1D",
+						Timezone: "America/New_York",
+						RRule:    "FREQ=WEEKLY;COUNT=2",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              1,
+					Start:           "2023-03-04 14:00",
+					End:             "2023-03-05 14:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-03-04 09:00",
+				},
+				models.Event{
+					ID:              1,
+					Start:           "2023-03-11 14:00",
+					End:             "2023-03-12 13:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-03-11 09:00",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success with the start and the end in different timezones",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:            1,
+						Start:         "2023-02-02 22:00",
+						End:           "2023-02-03 14:00",
+						StartTimezone: "America/Bogota",
+						EndTimezone:   "Europe/Madrid",
+					},
+					models.Event{
+						ID:          2,
+						Start:       "2023-02-02 13:00",
+						End:         "2023-02-02 14:00",
+						Timezone:    "America/Bogota",
+						EndTimezone: "America/New_York",
+					},
+					models.Event{
+						ID:            3,
+						Start:         "2023-02-03 12:00",
+						End:           "2023-02-03 13:00",
+						Timezone:      "America/Bogota",
+						StartTimezone: "Europe/Madrid",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{ID: 1, Start: "2023-02-03 03:00", End: "2023-02-03 13:00", Timezone: "UTC"},
+				models.Event{ID: 2, Start: "2023-02-02 18:00", End: "2023-02-02 19:00", Timezone: "UTC"},
+				models.Event{ID: 3, Start: "2023-02-03 11:00", End: "2023-02-03 18:00", Timezone: "UTC"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error with an end before the start in another timezone",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:            1,
+						Start:         "2023-02-02 22:00",
+						End:           "2023-02-03 02:00",
+						StartTimezone: "America/Bogota",
+						EndTimezone:   "Europe/Madrid",
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error loading the end location",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:          1,
+						Start:       "2023-02-02 13:00",
+						End:         "2023-02-02 14:00",
+						Timezone:    "America/Bogota",
+						EndTimezone: "WRONG",
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error with both the end and the duration",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-02 13:00",
+						End:      "2023-02-02 14:00",
+						Duration: "PT1H",
+						Timezone: "America/Bogota",
+					},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error without the end and the duration",
+			args: args{
+				events: models.Events{
+					models.Event{ID: 1, Start: "2023-02-02 13:00", Timezone: "America/Bogota"},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error parsing the duration",
+			args: args{
+				events: models.Events{
+					models.Event{ID: 1, Start: "2023-02-02 13:00", Duration: "1h", Timezone: "America/Bogota"},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error with a duration of an all-day event that is not whole days",
+			args: args{
+				events: models.Events{
+					models.Event{ID: 1, Start: "2023-02-01", Duration: "PT12H", Timezone: "America/Bogota"},
+				},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
+		{
+			name: "Error parsing the horizon",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       1,
+						Start:    "2023-02-01 13:00",
+						End:      "2023-02-01 14:00",
+						Timezone: "America/Bogota",
+					},
+				},
+				options: models.Options{HorizonEnd: "WRONG"},
+			},
+			want:    models.Events{},
+			wantErr: true,
+		},
 		{
 			name: "Error loading location",
 			args: args{
@@ -132,7 +688,7 @@ func TestParseEventsToUTCUC_Handle(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			uc := &ParseEventsToUTCUC{}
-			got, err := uc.Handle(tt.args.events)
+			got, _, err := uc.Handle(tt.args.events, tt.args.options)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
 
@@ -144,3 +700,206 @@ func TestParseEventsToUTCUC_Handle(t *testing.T) {
 		})
 	}
 }
+
+// TestParseEventsToUTCUC_HandleDST test for this method with local times in a DST gap or fold
+func TestParseEventsToUTCUC_HandleDST(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		events  models.Events
+		options models.Options
+	}
+
+	gap := models.Event{
+		ID:       1,
+		Start:    "2023-03-12 02:30",
+		End:      "2023-03-12 03:30",
+		Timezone: "America/New_York",
+	}
+
+	fold := models.Event{
+		ID:       2,
+		Start:    "2023-11-05 01:30",
+		End:      "2023-11-05 02:30",
+		Timezone: "America/New_York",
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		want         models.Events
+		wantWarnings models.Warnings
+		wantErr      bool
+	}{
+		{
+			name: "Success resolving to the earlier instant by default",
+			args: args{
+				events: models.Events{gap, fold},
+			},
+			want: models.Events{
+				models.Event{ID: 1, Start: "2023-03-12 06:30", End: "2023-03-12 07:30", Timezone: "UTC"},
+				models.Event{ID: 2, Start: "2023-11-05 05:30", End: "2023-11-05 07:30", Timezone: "UTC"},
+			},
+			wantWarnings: models.Warnings{
+				{
+					Code:        models.WarningCodeDSTGap,
+					Event:       1,
+					LocalTime:   "2023-03-12 02:30",
+					Timezone:    "America/New_York",
+					ResolvedUTC: "2023-03-12 06:30",
+					Message: "Local time 2023-03-12 02:30 does not exist because of a DST change in " +
+						"America/New_York, the earlier instant 2023-03-12 06:30 UTC is used",
+				},
+				{
+					Code:        models.WarningCodeDSTFold,
+					Event:       2,
+					LocalTime:   "2023-11-05 01:30",
+					Timezone:    "America/New_York",
+					ResolvedUTC: "2023-11-05 05:30",
+					Message: "Local time 2023-11-05 01:30 happens twice because of a DST change in " +
+						"America/New_York, the earlier instant 2023-11-05 05:30 UTC is used",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success resolving to the later instant",
+			args: args{
+				events:  models.Events{gap, fold},
+				options: models.Options{DSTPolicy: models.DSTPolicyLater},
+			},
+			want: models.Events{
+				models.Event{ID: 1, Start: "2023-03-12 07:30", End: "2023-03-12 07:30", Timezone: "UTC"},
+				models.Event{ID: 2, Start: "2023-11-05 06:30", End: "2023-11-05 07:30", Timezone: "UTC"},
+			},
+			wantWarnings: models.Warnings{
+				{
+					Code:        models.WarningCodeDSTGap,
+					Event:       1,
+					LocalTime:   "2023-03-12 02:30",
+					Timezone:    "America/New_York",
+					ResolvedUTC: "2023-03-12 07:30",
+					Message: "Local time 2023-03-12 02:30 does not exist because of a DST change in " +
+						"America/New_York, the later instant 2023-03-12 07:30 UTC is used",
+				},
+				{
+					Code:        models.WarningCodeDSTFold,
+					Event:       2,
+					LocalTime:   "2023-11-05 01:30",
+					Timezone:    "America/New_York",
+					ResolvedUTC: "2023-11-05 06:30",
+					Message: "Local time 2023-11-05 01:30 happens twice because of a DST change in " +
+						"America/New_York, the later instant 2023-11-05 06:30 UTC is used",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success warning about the occurrence of a recurring event in a fold",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:       3,
+						Start:    "2023-11-04 01:30",
+						End:      "2023-11-04 02:00",
+						Timezone: "America/New_York",
+						RRule:    "FREQ=DAILY;COUNT=2",
+					},
+				},
+			},
+			want: models.Events{
+				models.Event{
+					ID:              3,
+					Start:           "2023-11-04 05:30",
+					End:             "2023-11-04 06:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-11-04 01:30",
+				},
+				models.Event{
+					ID:              3,
+					Start:           "2023-11-05 05:30",
+					End:             "2023-11-05 06:00",
+					Timezone:        "UTC",
+					OccurrenceStart: "2023-11-05 01:30",
+				},
+			},
+			wantWarnings: models.Warnings{
+				{
+					Code:        models.WarningCodeDSTFold,
+					Event:       3,
+					Occurrence:  "2023-11-05 01:30",
+					LocalTime:   "2023-11-05 01:30",
+					Timezone:    "America/New_York",
+					ResolvedUTC: "2023-11-05 05:30",
+					Message: "Local time 2023-11-05 01:30 happens twice because of a DST change in " +
+						"America/New_York, the earlier instant 2023-11-05 05:30 UTC is used",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without warnings outside the DST changes",
+			args: args{
+				events: models.Events{
+					models.Event{ID: 4, Start: "2023-03-12 03:30", End: "2023-03-12 04:30", Timezone: "America/New_York"},
+				},
+				options: models.Options{DSTPolicy: models.DSTPolicyReject},
+			},
+			want: models.Events{
+				models.Event{ID: 4, Start: "2023-03-12 07:30", End: "2023-03-12 08:30", Timezone: "UTC"},
+			},
+			wantWarnings: nil,
+			wantErr:      false,
+		},
+		{
+			name: "Error rejecting a local time in a gap",
+			args: args{
+				events:  models.Events{gap},
+				options: models.Options{DSTPolicy: models.DSTPolicyReject},
+			},
+			want:         models.Events{},
+			wantWarnings: nil,
+			wantErr:      true,
+		},
+		{
+			name: "Error rejecting a local time in a fold",
+			args: args{
+				events:  models.Events{fold},
+				options: models.Options{DSTPolicy: models.DSTPolicyReject},
+			},
+			want:         models.Events{},
+			wantWarnings: nil,
+			wantErr:      true,
+		},
+		{
+			name: "Error parsing the DST policy",
+			args: args{
+				events:  models.Events{gap},
+				options: models.Options{DSTPolicy: "WRONG"},
+			},
+			want:         models.Events{},
+			wantWarnings: nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			uc := &ParseEventsToUTCUC{}
+			got, warnings, err := uc.Handle(tt.args.events, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(warnings, tt.wantWarnings) {
+				t.Errorf("Handle() warnings = %v, want %v", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
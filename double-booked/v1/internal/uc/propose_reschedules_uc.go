@@ -0,0 +1,288 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// rescheduleStep distance between the starts of the slots tried for an event
+const rescheduleStep = 15 * time.Minute
+
+// defaultSuggestions number of alternatives proposed for every event when it is not given
+const defaultSuggestions = 3
+
+// ProposeReschedulesUC declaration of use case struct used in this file
+type ProposeReschedulesUC struct{}
+
+// Handle propose new slots for the lower priority event of every confirmed conflict of the events given. The
+// slots are inside the window and the working hours, start after the minimum notice and do not create new
+// conflicts with the events that stay or with the first alternative of the events moved before. The events,
+// the window and now are expected in UTC and the slots are shown in the timezone of the options
+func (uc *ProposeReschedulesUC) Handle(
+	events models.Events,
+	window models.Event,
+	request models.RescheduleRequest,
+	options models.Options,
+) (models.RescheduleReport, error) {
+	rules, err := resolveConflictRules(options)
+	if err != nil {
+		return models.RescheduleReport{}, err
+	}
+
+	location, err := resolveLocation(options)
+	if err != nil {
+		return models.RescheduleReport{}, err
+	}
+
+	from, to, err := parseWindow(window)
+	if err != nil {
+		return models.RescheduleReport{}, err
+	}
+
+	hours, err := parseWorkingHours(request.WorkingHours)
+	if err != nil {
+		return models.RescheduleReport{}, err
+	}
+
+	earliest, suggestions, err := resolveRescheduleRequest(request)
+	if err != nil {
+		return models.RescheduleReport{}, err
+	}
+
+	if earliest.After(from) {
+		from = earliest
+	}
+
+	parsed, err := parseIntervals(events)
+	if err != nil {
+		return models.RescheduleReport{}, err
+	}
+
+	intervals := rules.participating(parsed)
+	sortIntervals(intervals)
+
+	conflicts, _ := splitTentative(rules.grade(sweepConflicts(intervals, rules.mode)))
+	moving := eventsToMove(conflicts)
+
+	// The events that stay keep their slots, so they block the new ones
+	var blocking []interval
+
+	for _, current := range intervals {
+		if !moving[keyOf(current)] {
+			blocking = append(blocking, current)
+		}
+	}
+
+	allowed := hours.ranges(location, from, to)
+	report := models.RescheduleReport{Proposals: []models.RescheduleProposal{}}
+
+	for _, current := range intervals {
+		if !moving[keyOf(current)] {
+			continue
+		}
+
+		slots := alternatives(current, blocking, allowed, rules.mode, suggestions)
+
+		// The first alternative is the one expected to be taken, so the next events can not use it
+		if len(slots) > 0 {
+			blocking = append(blocking, slots[0])
+		}
+
+		report.Proposals = append(report.Proposals, models.RescheduleProposal{
+			Event:         current.event.ID,
+			Occurrence:    current.event.OccurrenceStart,
+			Priority:      eventPriority(current.event),
+			ConflictsWith: doubleBookedWith(current, conflicts),
+			Alternatives:  toRescheduleSlots(current, slots, location),
+		})
+	}
+
+	return report, nil
+}
+
+// resolveRescheduleRequest validate the request given, it returns the earliest start allowed by the minimum
+// notice and the number of alternatives per event
+func resolveRescheduleRequest(request models.RescheduleRequest) (time.Time, int, error) {
+	if request.MinimumNoticeMinutes < 0 {
+		return time.Time{}, 0, invalidOptionError(
+			"minimum_notice_minutes", strconv.Itoa(request.MinimumNoticeMinutes))
+	}
+
+	if request.Suggestions < 0 {
+		return time.Time{}, 0, invalidOptionError("suggestions", strconv.Itoa(request.Suggestions))
+	}
+
+	now := time.Now().UTC()
+
+	if request.Now != "" {
+		parsed, err := parseUTCTime(request.Now)
+		if err != nil {
+			return time.Time{}, 0, invalidOptionError("now", request.Now)
+		}
+
+		now = parsed
+	}
+
+	suggestions := request.Suggestions
+	if suggestions == 0 {
+		suggestions = defaultSuggestions
+	}
+
+	return now.Add(time.Duration(request.MinimumNoticeMinutes) * time.Minute), suggestions, nil
+}
+
+// eventsToMove find the lower priority interval of every conflict given, ties move the one that starts later
+// and then the one with the higher ID
+func eventsToMove(conflicts []conflict) map[occurrenceKey]bool {
+	moving := map[occurrenceKey]bool{}
+
+	for _, c := range conflicts {
+		moved := c.second
+
+		switch first, second := eventPriority(c.first.event), eventPriority(c.second.event); {
+		case first < second:
+			moved = c.first
+		case first == second && c.first.start.After(c.second.start):
+			moved = c.first
+		}
+
+		moving[keyOf(moved)] = true
+	}
+
+	return moving
+}
+
+// doubleBookedWith IDs of the events double booked with the interval given, sorted and without duplicates
+func doubleBookedWith(current interval, conflicts []conflict) []int {
+	key := keyOf(current)
+	involved := map[int]bool{}
+
+	for _, c := range conflicts {
+		switch key {
+		case keyOf(c.first):
+			involved[c.second.event.ID] = true
+		case keyOf(c.second):
+			involved[c.first.event.ID] = true
+		}
+	}
+
+	return sortedBookings(involved)
+}
+
+// alternatives find the slots with the duration of the interval given inside the allowed ranges that do not
+// overlap the blocking intervals it would be double booked with. The slots start every rescheduleStep from the
+// start of every free range and are ranked from the closest to the current start, ties by the earliest
+func alternatives(
+	current interval,
+	blocking []interval,
+	allowed []timeRange,
+	mode models.OverlapMode,
+	suggestions int,
+) []interval {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	var blockers []interval
+
+	for _, booked := range blocking {
+		if booked.event.ID != current.event.ID && blocks(booked, current) {
+			blockers = append(blockers, booked)
+		}
+	}
+
+	sortIntervals(blockers)
+
+	busy := mergeRanges(blockers, allowed[0].start, allowed[len(allowed)-1].end)
+	duration := current.end.Sub(current.start)
+
+	var slots []interval
+
+	for _, free := range subtractRanges(allowed, busy) {
+		for start := free.start; !start.Add(duration).After(free.end); start = start.Add(rescheduleStep) {
+			slot := interval{event: current.event, start: start, end: start.Add(duration), attendees: current.attendees}
+
+			if touchesBusy(slot, busy, mode) {
+				continue
+			}
+
+			slots = append(slots, slot)
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		shiftI, shiftJ := absDuration(slots[i].start.Sub(current.start)), absDuration(slots[j].start.Sub(current.start))
+		if shiftI != shiftJ {
+			return shiftI < shiftJ
+		}
+
+		return slots[i].start.Before(slots[j].start)
+	})
+
+	if len(slots) > suggestions {
+		slots = slots[:suggestions]
+	}
+
+	return slots
+}
+
+// blocks check if the interval booked would be double booked with the interval given when they overlap, the
+// bookings of the same resource are never overlapped and the rest block it when they share attendees
+func blocks(booked, current interval) bool {
+	if sameResource(booked, current) {
+		return true
+	}
+
+	_, ok := sharedAttendees(booked, current)
+
+	return ok
+}
+
+// touchesBusy check if the slot given shares a boundary with a busy block, which is double booked in the overlap
+// modes where touching counts
+func touchesBusy(slot interval, busy []timeRange, mode models.OverlapMode) bool {
+	if mode == models.OverlapModeHalfOpen || (mode == models.OverlapModeTouchingCounts && slot.start.Equal(slot.end)) {
+		return false
+	}
+
+	for _, block := range busy {
+		if block.end.Equal(slot.start) || block.start.Equal(slot.end) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// absDuration absolute value of the duration given
+func absDuration(duration time.Duration) time.Duration {
+	if duration < 0 {
+		return -duration
+	}
+
+	return duration
+}
+
+// toRescheduleSlots convert the slots of the interval given in the ranked list returned in the response
+func toRescheduleSlots(current interval, slots []interval, location *time.Location) []models.RescheduleSlot {
+	ranked := make([]models.RescheduleSlot, 0, len(slots))
+
+	for i, slot := range slots {
+		ranked = append(ranked, models.RescheduleSlot{
+			Rank:         i + 1,
+			SlotUTC:      newTimeWindow(slot.start, slot.end, time.UTC),
+			SlotLocal:    newTimeWindow(slot.start, slot.end, location),
+			ShiftMinutes: int64(slot.start.Sub(current.start) / time.Minute),
+		})
+	}
+
+	return ranked
+}
+
+// NewProposeReschedulesUC initialize this use case
+func NewProposeReschedulesUC() *ProposeReschedulesUC {
+	return &ProposeReschedulesUC{}
+}
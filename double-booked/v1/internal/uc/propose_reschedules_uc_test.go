@@ -0,0 +1,323 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+)
+
+// TestProposeReschedulesUC_Handle test for this method
+func TestProposeReschedulesUC_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		events  models.Events
+		window  models.Event
+		request models.RescheduleRequest
+		options models.Options
+	}
+
+	calendar := models.Events{
+		models.Event{
+			ID:       1,
+			Start:    "2023-02-02 09:00",
+			End:      "2023-02-02 10:00",
+			Timezone: "UTC",
+			Priority: 5,
+		},
+		models.Event{
+			ID:       2,
+			Start:    "2023-02-02 09:30",
+			End:      "2023-02-02 10:30",
+			Timezone: "UTC",
+		},
+		models.Event{
+			ID:       3,
+			Start:    "2023-02-02 11:00",
+			End:      "2023-02-02 12:00",
+			Timezone: "UTC",
+		},
+	}
+
+	window := models.Event{Start: "2023-02-02 08:00", End: "2023-02-02 18:00", Timezone: "UTC"}
+	workingHours := models.WorkingHours{Start: "09:00", End: "17:00"}
+
+	slot := func(rank int, start, end string, shift int64) models.RescheduleSlot {
+		return models.RescheduleSlot{
+			Rank:         rank,
+			SlotUTC:      models.TimeWindow{Start: start, End: end, Timezone: "UTC"},
+			SlotLocal:    models.TimeWindow{Start: start, End: end, Timezone: "UTC"},
+			ShiftMinutes: shift,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    models.RescheduleReport
+		wantErr bool
+	}{
+		{
+			name: "Success moving the lower priority event",
+			args: args{
+				events:  calendar,
+				window:  window,
+				request: models.RescheduleRequest{WorkingHours: workingHours, Now: "2023-02-02 07:00"},
+			},
+			want: models.RescheduleReport{
+				Proposals: []models.RescheduleProposal{
+					{
+						Event:         2,
+						Priority:      1,
+						ConflictsWith: []int{1},
+						Alternatives: []models.RescheduleSlot{
+							slot(1, "2023-02-02 10:00", "2023-02-02 11:00", 30),
+							slot(2, "2023-02-02 12:00", "2023-02-02 13:00", 150),
+							slot(3, "2023-02-02 12:15", "2023-02-02 13:15", 165),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success after the minimum notice",
+			args: args{
+				events: calendar,
+				window: window,
+				request: models.RescheduleRequest{
+					WorkingHours:         workingHours,
+					MinimumNoticeMinutes: 90,
+					Now:                  "2023-02-02 09:00",
+					Suggestions:          2,
+				},
+			},
+			want: models.RescheduleReport{
+				Proposals: []models.RescheduleProposal{
+					{
+						Event:         2,
+						Priority:      1,
+						ConflictsWith: []int{1},
+						Alternatives: []models.RescheduleSlot{
+							slot(1, "2023-02-02 12:00", "2023-02-02 13:00", 150),
+							slot(2, "2023-02-02 12:15", "2023-02-02 13:15", 165),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without touching other events in closed mode",
+			args: args{
+				events: calendar,
+				window: window,
+				request: models.RescheduleRequest{
+					WorkingHours: workingHours,
+					Now:          "2023-02-02 07:00",
+					Suggestions:  2,
+				},
+				options: models.Options{OverlapMode: models.OverlapModeClosed},
+			},
+			want: models.RescheduleReport{
+				Proposals: []models.RescheduleProposal{
+					{
+						Event:         2,
+						Priority:      1,
+						ConflictsWith: []int{1},
+						Alternatives: []models.RescheduleSlot{
+							slot(1, "2023-02-02 12:15", "2023-02-02 13:15", 165),
+							slot(2, "2023-02-02 12:30", "2023-02-02 13:30", 180),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success avoiding the attendees booked in other resources",
+			args: args{
+				events: models.Events{
+					models.Event{
+						ID:         1,
+						Start:      "2023-02-02 09:00",
+						End:        "2023-02-02 10:00",
+						Timezone:   "UTC",
+						ResourceID: "room-a",
+						Attendees:  []string{"ana"},
+						Priority:   5,
+					},
+					models.Event{
+						ID:         2,
+						Start:      "2023-02-02 09:30",
+						End:        "2023-02-02 10:30",
+						Timezone:   "UTC",
+						ResourceID: "room-b",
+						Attendees:  []string{"ana"},
+					},
+					models.Event{
+						ID:         3,
+						Start:      "2023-02-02 10:00",
+						End:        "2023-02-02 11:00",
+						Timezone:   "UTC",
+						ResourceID: "room-c",
+						Attendees:  []string{"ana"},
+						Priority:   5,
+					},
+				},
+				window:  window,
+				request: models.RescheduleRequest{WorkingHours: workingHours, Now: "2023-02-02 07:00"},
+			},
+			want: models.RescheduleReport{
+				Proposals: []models.RescheduleProposal{
+					{
+						Event:         2,
+						Priority:      1,
+						ConflictsWith: []int{1, 3},
+						Alternatives: []models.RescheduleSlot{
+							slot(1, "2023-02-02 11:00", "2023-02-02 12:00", 90),
+							slot(2, "2023-02-02 11:15", "2023-02-02 12:15", 105),
+							slot(3, "2023-02-02 11:30", "2023-02-02 12:30", 120),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without slots left",
+			args: args{
+				events: calendar,
+				window: window,
+				request: models.RescheduleRequest{
+					WorkingHours: models.WorkingHours{Start: "09:00", End: "10:00"},
+					Now:          "2023-02-02 07:00",
+				},
+			},
+			want: models.RescheduleReport{
+				Proposals: []models.RescheduleProposal{
+					{
+						Event:         2,
+						Priority:      1,
+						ConflictsWith: []int{1},
+						Alternatives:  []models.RescheduleSlot{},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success without conflicts",
+			args: args{
+				events:  calendar[:1],
+				window:  window,
+				request: models.RescheduleRequest{Now: "2023-02-02 07:00"},
+			},
+			want:    models.RescheduleReport{Proposals: []models.RescheduleProposal{}},
+			wantErr: false,
+		},
+		{
+			name: "Fail by invalid window",
+			args: args{
+				events: calendar,
+				window: models.Event{Start: "2023-02-02 18:00", End: "2023-02-02 08:00", Timezone: "UTC"},
+			},
+			want:    models.RescheduleReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid working hours",
+			args: args{
+				events:  calendar,
+				window:  window,
+				request: models.RescheduleRequest{WorkingHours: models.WorkingHours{Start: "17:00", End: "09:00"}},
+			},
+			want:    models.RescheduleReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by negative minimum notice",
+			args: args{
+				events:  calendar,
+				window:  window,
+				request: models.RescheduleRequest{MinimumNoticeMinutes: -1},
+			},
+			want:    models.RescheduleReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by negative suggestions",
+			args: args{
+				events:  calendar,
+				window:  window,
+				request: models.RescheduleRequest{Suggestions: -1},
+			},
+			want:    models.RescheduleReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid now",
+			args: args{
+				events:  calendar,
+				window:  window,
+				request: models.RescheduleRequest{Now: "WRONG"},
+			},
+			want:    models.RescheduleReport{},
+			wantErr: true,
+		},
+		{
+			name: "Fail by invalid overlap mode",
+			args: args{
+				events:  calendar,
+				window:  window,
+				options: models.Options{OverlapMode: "WRONG"},
+			},
+			want:    models.RescheduleReport{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := &ProposeReschedulesUC{}
+			got, err := uc.Handle(tt.args.events, tt.args.window, tt.args.request, tt.args.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Handle() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Handle() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewProposeReschedulesUC test for this method
+func TestNewProposeReschedulesUC(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want *ProposeReschedulesUC
+	}{
+		{
+			name: "Success",
+			want: NewProposeReschedulesUC(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewProposeReschedulesUC(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewProposeReschedulesUC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
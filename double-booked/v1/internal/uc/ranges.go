@@ -0,0 +1,112 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"time"
+)
+
+// timeRange window of time [start, end)
+type timeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// mergeRanges merge the intervals clipped to [from, to) in the busy blocks where at least one event runs,
+// the intervals must be sorted by start and back-to-back intervals are merged in the same block
+func mergeRanges(intervals []interval, from, to time.Time) []timeRange {
+	var busy []timeRange
+
+	for _, current := range intervals {
+		start, end := current.start, current.end
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		if !end.After(start) {
+			continue
+		}
+
+		if len(busy) > 0 && !start.After(busy[len(busy)-1].end) {
+			if end.After(busy[len(busy)-1].end) {
+				busy[len(busy)-1].end = end
+			}
+
+			continue
+		}
+
+		busy = append(busy, timeRange{start: start, end: end})
+	}
+
+	return busy
+}
+
+// freeRanges return the gaps of [from, to) not covered by the busy blocks given, they must be merged and sorted
+func freeRanges(busy []timeRange, from, to time.Time) []timeRange {
+	var free []timeRange
+
+	cursor := from
+
+	for _, block := range busy {
+		if !block.start.Before(to) {
+			break
+		}
+
+		if block.start.After(cursor) {
+			free = append(free, timeRange{start: cursor, end: block.start})
+		}
+
+		if block.end.After(cursor) {
+			cursor = block.end
+		}
+	}
+
+	if to.After(cursor) {
+		free = append(free, timeRange{start: cursor, end: to})
+	}
+
+	return free
+}
+
+// intersectRanges return the ranges covered by both lists given, they must be sorted and not overlap each other
+func intersectRanges(a, b []timeRange) []timeRange {
+	var intersection []timeRange
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].start, a[i].end
+		if b[j].start.After(start) {
+			start = b[j].start
+		}
+
+		if b[j].end.Before(end) {
+			end = b[j].end
+		}
+
+		if end.After(start) {
+			intersection = append(intersection, timeRange{start: start, end: end})
+		}
+
+		if a[i].end.Before(b[j].end) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return intersection
+}
+
+// subtractRanges return the parts of the ranges given not covered by the busy blocks, both must be sorted and
+// not overlap each other
+func subtractRanges(ranges, busy []timeRange) []timeRange {
+	var available []timeRange
+
+	for _, current := range ranges {
+		available = append(available, freeRanges(busy, current.start, current.end)...)
+	}
+
+	return available
+}
@@ -0,0 +1,535 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultHorizonYears years expanded for each recurring event when the horizon is not given
+	defaultHorizonYears = 1
+	// maxOccurrences maximum number of occurrences a recurring event can have inside the horizon
+	maxOccurrences = 10000
+	// maxInterval maximum INTERVAL of a recurrence rule, it keeps the periods inside the dates time can represent
+	maxInterval = 1000
+	// maxCount maximum COUNT of a recurrence rule
+	maxCount = 100000
+	// untilDateTimeUTCFormat format of an UNTIL rule part given in UTC
+	untilDateTimeUTCFormat = "20060102T150405Z"
+	// untilDateTimeFormat format of an UNTIL rule part given in the event timezone
+	untilDateTimeFormat = "20060102T150405"
+	// untilDateFormat format of an UNTIL rule part given as a date
+	untilDateFormat = "20060102"
+)
+
+// List of frequencies supported in the recurrence rules
+const (
+	frequencyDaily   = "DAILY"
+	frequencyWeekly  = "WEEKLY"
+	frequencyMonthly = "MONTHLY"
+	frequencyYearly  = "YEARLY"
+)
+
+// weekdayCodes RFC 5545 codes of the week days
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// byDay week day of a BYDAY rule part, ordinal is zero when every week day of the period matches
+type byDay struct {
+	weekday time.Weekday
+	ordinal int
+}
+
+// recurrenceRule RFC 5545 recurrence rule supporting FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY,
+// BYMONTH and WKST
+type recurrenceRule struct {
+	frequency   string
+	interval    int
+	count       int
+	until       time.Time
+	byDay       []byDay
+	byMonthDay  []int
+	byMonth     map[time.Month]bool
+	weekStartAt time.Weekday
+}
+
+// parseRecurrenceRule parse the recurrence rule given, the UNTIL values without UTC designator are read in
+// the location of the event
+func parseRecurrenceRule(rule string, location *time.Location) (recurrenceRule, error) {
+	parsed := recurrenceRule{interval: 1, weekStartAt: time.Monday}
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(part, "=")
+		if !found {
+			return recurrenceRule{}, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			parsed.frequency = strings.ToUpper(value)
+		case "INTERVAL":
+			parsed.interval, err = parsePositive(value, maxInterval)
+		case "COUNT":
+			parsed.count, err = parsePositive(value, maxCount)
+		case "UNTIL":
+			parsed.until, err = parseUntil(value, location)
+		case "BYDAY":
+			parsed.byDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			parsed.byMonthDay, err = parseByMonthDay(value)
+		case "BYMONTH":
+			parsed.byMonth, err = parseByMonth(value)
+		case "WKST":
+			weekday, ok := weekdayCodes[strings.ToUpper(value)]
+			if !ok {
+				err = fmt.Errorf("invalid week day %q", value)
+			}
+
+			parsed.weekStartAt = weekday
+		default:
+			err = fmt.Errorf("unsupported rule part %q", name)
+		}
+
+		if err != nil {
+			return recurrenceRule{}, err
+		}
+	}
+
+	switch parsed.frequency {
+	case frequencyDaily, frequencyWeekly, frequencyMonthly, frequencyYearly:
+	default:
+		return recurrenceRule{}, fmt.Errorf("unsupported frequency %q", parsed.frequency)
+	}
+
+	if parsed.count > 0 && !parsed.until.IsZero() {
+		return recurrenceRule{}, fmt.Errorf("COUNT and UNTIL can not be used together")
+	}
+
+	return parsed, nil
+}
+
+// parsePositive parse a number greater than zero and not greater than the maximum given
+func parsePositive(value string, maximum int) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 || number > maximum {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+
+	return number, nil
+}
+
+// parseUntil parse the UNTIL rule part, a date includes the whole day
+func parseUntil(value string, location *time.Location) (time.Time, error) {
+	if until, err := time.Parse(untilDateTimeUTCFormat, value); err == nil {
+		return until, nil
+	}
+
+	if until, err := time.ParseInLocation(untilDateTimeFormat, value, location); err == nil {
+		return until, nil
+	}
+
+	if until, err := time.ParseInLocation(untilDateFormat, value, location); err == nil {
+		return until.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid until %q", value)
+}
+
+// parseByDay parse the BYDAY rule part, for example MO,WE or 1MO,-1FR
+func parseByDay(value string) ([]byDay, error) {
+	var days []byDay
+
+	for _, day := range strings.Split(strings.ToUpper(value), ",") {
+		if len(day) < 2 {
+			return nil, fmt.Errorf("invalid week day %q", day)
+		}
+
+		weekday, ok := weekdayCodes[day[len(day)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid week day %q", day)
+		}
+
+		ordinal := 0
+
+		if prefix := day[:len(day)-2]; prefix != "" {
+			number, err := strconv.Atoi(prefix)
+			if err != nil || number == 0 || number > 53 || number < -53 {
+				return nil, fmt.Errorf("invalid week day %q", day)
+			}
+
+			ordinal = number
+		}
+
+		days = append(days, byDay{weekday: weekday, ordinal: ordinal})
+	}
+
+	return days, nil
+}
+
+// parseByMonthDay parse the BYMONTHDAY rule part, negative days count from the end of the month
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+
+	for _, day := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(day)
+		if err != nil || number == 0 || number > 31 || number < -31 {
+			return nil, fmt.Errorf("invalid month day %q", day)
+		}
+
+		days = append(days, number)
+	}
+
+	return days, nil
+}
+
+// parseByMonth parse the BYMONTH rule part
+func parseByMonth(value string) (map[time.Month]bool, error) {
+	months := map[time.Month]bool{}
+
+	for _, month := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(month)
+		if err != nil || number < 1 || number > 12 {
+			return nil, fmt.Errorf("invalid month %q", month)
+		}
+
+		months[time.Month(number)] = true
+	}
+
+	return months, nil
+}
+
+// occurrences expand the rule from the first occurrence given and return the starts of the occurrences that
+// overlap [from, to) for events of the duration given. Every occurrence keeps the wall clock time of the first
+// one in its location, so the occurrences follow the DST changes of the event timezone
+func (r recurrenceRule) occurrences(first time.Time, duration time.Duration, from, to time.Time) ([]time.Time, error) {
+	var starts []time.Time
+
+	emitted := 0
+
+	// emit return false when the expansion is over
+	emit := func(start time.Time) (bool, error) {
+		if (r.count > 0 && emitted == r.count) || (!r.until.IsZero() && start.After(r.until)) || !start.Before(to) {
+			return false, nil
+		}
+
+		emitted++
+
+		if start.Add(duration).After(from) || (duration == 0 && !start.Before(from)) {
+			if len(starts) == maxOccurrences {
+				return false, fmt.Errorf("more than %d occurrences inside the horizon", maxOccurrences)
+			}
+
+			starts = append(starts, start)
+		}
+
+		return true, nil
+	}
+
+	// The first occurrence is always part of the recurrence set
+	if next, err := emit(first); !next || err != nil {
+		return starts, err
+	}
+
+	var previous time.Time
+
+	for period := 0; ; period++ {
+		periodStart := r.periodStart(first, period)
+		if !periodStart.Before(to) || (!r.until.IsZero() && periodStart.After(r.until)) {
+			return starts, nil
+		}
+
+		// A period that does not move forward would expand the same days forever
+		if period > 0 && !periodStart.After(previous) {
+			return starts, nil
+		}
+
+		previous = periodStart
+
+		for _, start := range r.candidates(first, periodStart) {
+			if !start.After(first) {
+				continue
+			}
+
+			if next, err := emit(start); !next || err != nil {
+				return starts, err
+			}
+		}
+	}
+}
+
+// periodStart first day of the period number given counted from the period of the first occurrence
+func (r recurrenceRule) periodStart(first time.Time, period int) time.Time {
+	year, month, day := first.Date()
+	location := first.Location()
+	step := period * r.interval
+
+	switch r.frequency {
+	case frequencyDaily:
+		return time.Date(year, month, day+step, 0, 0, 0, 0, location)
+	case frequencyWeekly:
+		offset := (int(first.Weekday()) - int(r.weekStartAt) + 7) % 7
+
+		return time.Date(year, month, day-offset+step*7, 0, 0, 0, 0, location)
+	case frequencyMonthly:
+		return time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, location)
+	default:
+		return time.Date(year+step, time.January, 1, 0, 0, 0, 0, location)
+	}
+}
+
+// candidates starts of the occurrences inside the period given, sorted and at the wall clock time of the first
+// occurrence
+func (r recurrenceRule) candidates(first, periodStart time.Time) []time.Time {
+	var days []time.Time
+
+	switch r.frequency {
+	case frequencyDaily:
+		if r.matchesDay(periodStart) {
+			days = append(days, periodStart)
+		}
+	case frequencyWeekly:
+		for offset := 0; offset < 7; offset++ {
+			day := periodStart.AddDate(0, 0, offset)
+			if r.matchesWeeklyDay(first, day) {
+				days = append(days, day)
+			}
+		}
+	case frequencyMonthly:
+		if len(r.byMonth) == 0 || r.byMonth[periodStart.Month()] {
+			days = r.monthDays(first, periodStart)
+		}
+	default:
+		days = r.yearDays(first, periodStart)
+	}
+
+	hour, minute, second := first.Clock()
+	starts := make([]time.Time, 0, len(days))
+
+	for _, day := range days {
+		starts = append(starts, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, first.Location()))
+	}
+
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	return starts
+}
+
+// matchesDay check the day given against the BYMONTH, BYMONTHDAY and BYDAY rule parts used as filters
+func (r recurrenceRule) matchesDay(day time.Time) bool {
+	if len(r.byMonth) > 0 && !r.byMonth[day.Month()] {
+		return false
+	}
+
+	if len(r.byMonthDay) > 0 && !matchesMonthDay(r.byMonthDay, day) {
+		return false
+	}
+
+	if len(r.byDay) == 0 {
+		return true
+	}
+
+	for _, weekday := range r.byDay {
+		if weekday.weekday == day.Weekday() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesWeeklyDay check if the day given is part of a weekly recurrence, by default the week day of the first
+// occurrence
+func (r recurrenceRule) matchesWeeklyDay(first, day time.Time) bool {
+	if len(r.byDay) == 0 {
+		return day.Weekday() == first.Weekday() && (len(r.byMonth) == 0 || r.byMonth[day.Month()])
+	}
+
+	return r.matchesDay(day)
+}
+
+// monthDays days of the month given matching the BYMONTHDAY and BYDAY rule parts, by default the day of the
+// month of the first occurrence
+func (r recurrenceRule) monthDays(first, monthStart time.Time) []time.Time {
+	lastDay := monthStart.AddDate(0, 1, -1).Day()
+
+	var days []time.Time
+
+	for dayNumber := 1; dayNumber <= lastDay; dayNumber++ {
+		day := monthStart.AddDate(0, 0, dayNumber-1)
+
+		switch {
+		case len(r.byMonthDay) == 0 && len(r.byDay) == 0:
+			if dayNumber != first.Day() {
+				continue
+			}
+		case len(r.byMonthDay) > 0 && !matchesMonthDay(r.byMonthDay, day):
+			continue
+		case len(r.byDay) > 0 && !matchesOrdinalWeekday(r.byDay, day, monthStart, monthStart.AddDate(0, 1, 0)):
+			continue
+		}
+
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// yearDays days of the year given matching the rule, by default the day and month of the first occurrence
+func (r recurrenceRule) yearDays(first, yearStart time.Time) []time.Time {
+	var days []time.Time
+
+	// Without BYMONTH the BYDAY ordinals are relative to the whole year
+	if len(r.byMonth) == 0 && len(r.byDay) > 0 && len(r.byMonthDay) == 0 {
+		yearEnd := yearStart.AddDate(1, 0, 0)
+
+		for day := yearStart; day.Before(yearEnd); day = day.AddDate(0, 0, 1) {
+			if matchesOrdinalWeekday(r.byDay, day, yearStart, yearEnd) {
+				days = append(days, day)
+			}
+		}
+
+		return days
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		monthStart := time.Date(yearStart.Year(), month, 1, 0, 0, 0, 0, yearStart.Location())
+
+		if len(r.byMonth) == 0 {
+			if month != first.Month() {
+				continue
+			}
+		} else if !r.byMonth[month] {
+			continue
+		}
+
+		days = append(days, r.monthDays(first, monthStart)...)
+	}
+
+	return days
+}
+
+// matchesMonthDay check if the day given is one of the month days, negative ones count from the end of the month
+func matchesMonthDay(monthDays []int, day time.Time) bool {
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+
+	for _, monthDay := range monthDays {
+		if monthDay == day.Day() || (monthDay < 0 && lastDay+monthDay+1 == day.Day()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesOrdinalWeekday check if the day given matches a week day, with its ordinal counted inside [from, to)
+func matchesOrdinalWeekday(weekdays []byDay, day, from, to time.Time) bool {
+	for _, weekday := range weekdays {
+		if weekday.weekday != day.Weekday() {
+			continue
+		}
+
+		if weekday.ordinal == 0 {
+			return true
+		}
+
+		fromStart := daysBetween(from, day)/7 + 1
+		fromEnd := -((daysBetween(day, to)-1)/7 + 1)
+
+		if weekday.ordinal == fromStart || weekday.ordinal == fromEnd {
+			return true
+		}
+	}
+
+	return false
+}
+
+// daysBetween number of calendar days between the dates given, it is not affected by the DST changes
+func daysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// recurrenceExceptions occurrences of a recurring event cancelled or moved, indexed by their original start
+type recurrenceExceptions struct {
+	cancelled map[int64]bool
+	moved     map[int64]timeRange
+}
+
+// parseRecurrenceExceptions parse the exdates and overrides of the event given, the original starts are read in
+// the location of the event and they are dates for the all-day events. The original starts follow the DST
+// policy of the resolver like the occurrences they identify, only the new starts and ends of the overrides warn.
+// The overrides without timezone keep the locations of the start and the end of the event
+func parseRecurrenceExceptions(
+	event models.Event,
+	location, endLocation *time.Location,
+	resolver *dstResolver,
+) (recurrenceExceptions, error) {
+	exceptions := recurrenceExceptions{cancelled: map[int64]bool{}, moved: map[int64]timeRange{}}
+	originals := &dstResolver{policy: resolver.policy}
+
+	for _, exDate := range event.ExDates {
+		occurrenceStart, _, err := parseLocalTime(exDate, location, originals)
+		if err != nil {
+			return recurrenceExceptions{}, fmt.Errorf("invalid exdate %q", exDate)
+		}
+
+		exceptions.cancelled[occurrenceStart.Unix()] = true
+	}
+
+	for _, override := range event.Overrides {
+		occurrenceStart, _, err := parseLocalTime(override.OccurrenceStart, location, originals)
+		if err != nil {
+			return recurrenceExceptions{}, fmt.Errorf("invalid override occurrence %q", override.OccurrenceStart)
+		}
+
+		overrideLocation, overrideEndLocation := location, endLocation
+
+		if override.Timezone != "" {
+			overrideLocation, err = time.LoadLocation(override.Timezone)
+			if err != nil {
+				return recurrenceExceptions{}, fmt.Errorf("invalid override timezone %q", override.Timezone)
+			}
+
+			overrideEndLocation = overrideLocation
+		}
+
+		moved, _, err := parseLocalRange(override.Start, override.End, overrideLocation, overrideEndLocation, resolver)
+		if err != nil || moved.end.Before(moved.start) {
+			return recurrenceExceptions{}, fmt.Errorf("invalid override of occurrence %q", override.OccurrenceStart)
+		}
+
+		exceptions.moved[occurrenceStart.Unix()] = moved
+	}
+
+	return exceptions, nil
+}
+
+// recurrenceError build the error returned when the recurrence of an event can not be expanded
+func recurrenceError(event models.Event, err error) error {
+	return &models.EventError{
+		Code:       models.CodeParseEventError,
+		ID:         models.IDDoubleBookedError,
+		Message:    fmt.Sprintf("Error expanding recurrence of event %v: %s", event, err.Error()),
+		StatusCode: models.CodeStatusHTTPBusinessError,
+	}
+}
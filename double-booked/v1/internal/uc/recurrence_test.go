@@ -0,0 +1,196 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Test_recurrenceRule_occurrences test for this method
+func Test_recurrenceRule_occurrences(t *testing.T) {
+	t.Parallel()
+
+	location, _ := time.LoadLocation("America/Bogota")
+
+	tests := []struct {
+		name  string
+		rule  string
+		first string
+		to    string
+		want  []string
+	}{
+		{
+			name:  "Daily with interval and count",
+			rule:  "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			first: "2023-02-01 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-02-01 09:00", "2023-02-03 09:00", "2023-02-05 09:00"},
+		},
+		{
+			name:  "Weekly on several days every two weeks",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4",
+			first: "2023-02-01 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-02-01 09:00", "2023-02-13 09:00", "2023-02-15 09:00", "2023-02-27 09:00"},
+		},
+		{
+			name:  "Monthly on the last friday",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			first: "2023-01-27 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-01-27 09:00", "2023-02-24 09:00", "2023-03-31 09:00"},
+		},
+		{
+			name:  "Monthly on the second tuesday",
+			rule:  "FREQ=MONTHLY;BYDAY=2TU;COUNT=2",
+			first: "2023-01-10 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-01-10 09:00", "2023-02-14 09:00"},
+		},
+		{
+			name:  "Monthly on the last day of the month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			first: "2023-01-31 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-01-31 09:00", "2023-02-28 09:00", "2023-03-31 09:00"},
+		},
+		{
+			name:  "Monthly on the 31st skips the shorter months",
+			rule:  "FREQ=MONTHLY;COUNT=3",
+			first: "2023-01-31 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-01-31 09:00", "2023-03-31 09:00", "2023-05-31 09:00"},
+		},
+		{
+			name:  "Yearly in the months given",
+			rule:  "FREQ=YEARLY;BYMONTH=1,7",
+			first: "2023-01-15 09:00",
+			to:    "2024-03-01 00:00",
+			want:  []string{"2023-01-15 09:00", "2023-07-15 09:00", "2024-01-15 09:00"},
+		},
+		{
+			name:  "Until given as a date includes the whole day",
+			rule:  "FREQ=DAILY;UNTIL=20230203",
+			first: "2023-02-01 18:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-02-01 18:00", "2023-02-02 18:00", "2023-02-03 18:00"},
+		},
+		{
+			name:  "Until given in UTC",
+			rule:  "FREQ=DAILY;UNTIL=20230202T140000Z",
+			first: "2023-02-01 09:00",
+			to:    "2024-01-01 00:00",
+			want:  []string{"2023-02-01 09:00", "2023-02-02 09:00"},
+		},
+		{
+			name:  "Only the occurrences inside the horizon",
+			rule:  "FREQ=WEEKLY",
+			first: "2023-02-01 09:00",
+			to:    "2023-02-20 00:00",
+			want:  []string{"2023-02-01 09:00", "2023-02-08 09:00", "2023-02-15 09:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := parseRecurrenceRule(tt.rule, location)
+			if err != nil {
+				t.Fatalf("parseRecurrenceRule() error = %v", err)
+			}
+
+			first, _ := time.ParseInLocation(LayoutFormat, tt.first, location)
+			to, _ := time.ParseInLocation(LayoutFormat, tt.to, location)
+
+			starts, err := rule.occurrences(first, time.Hour, first, to)
+			if err != nil {
+				t.Fatalf("occurrences() error = %v", err)
+			}
+
+			got := make([]string, 0, len(starts))
+			for _, start := range starts {
+				got = append(got, start.Format(LayoutFormat))
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_parseRecurrenceRule test for this method
+func Test_parseRecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "Valid rule with prefix", rule: "RRULE:FREQ=WEEKLY;BYDAY=MO,FR;WKST=SU", wantErr: false},
+		{name: "Frequency not supported", rule: "FREQ=HOURLY", wantErr: true},
+		{name: "Missing frequency", rule: "COUNT=2", wantErr: true},
+		{name: "Rule part not supported", rule: "FREQ=DAILY;BYSETPOS=1", wantErr: true},
+		{name: "Count and until together", rule: "FREQ=DAILY;COUNT=2;UNTIL=20230203", wantErr: true},
+		{name: "Invalid interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "Interval too large", rule: "FREQ=DAILY;INTERVAL=9223372036854775807", wantErr: true},
+		{name: "Yearly interval too large", rule: "FREQ=YEARLY;INTERVAL=1001", wantErr: true},
+		{name: "Count too large", rule: "FREQ=WEEKLY;COUNT=100001", wantErr: true},
+		{name: "Invalid week day", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "Invalid month day", rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := parseRecurrenceRule(tt.rule, time.UTC); (err != nil) != tt.wantErr {
+				t.Errorf("parseRecurrenceRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Test_recurrenceRule_occurrences_limit test the expansion is bounded
+func Test_recurrenceRule_occurrences_limit(t *testing.T) {
+	t.Parallel()
+
+	rule, _ := parseRecurrenceRule("FREQ=DAILY", time.UTC)
+	first := time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	if _, err := rule.occurrences(first, time.Hour, first, first.AddDate(100, 0, 0)); err == nil {
+		t.Errorf("occurrences() expected an error with more than %d occurrences", maxOccurrences)
+	}
+}
+
+// Test_recurrenceRule_occurrences_largeInterval test the expansion ends with the largest intervals supported
+func Test_recurrenceRule_occurrences_largeInterval(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"FREQ=DAILY", "FREQ=WEEKLY", "FREQ=MONTHLY", "FREQ=YEARLY"} {
+		rule, err := parseRecurrenceRule(value+";INTERVAL=1000", time.UTC)
+		if err != nil {
+			t.Fatalf("parseRecurrenceRule() error = %v", err)
+		}
+
+		starts, err := rule.occurrences(first, time.Hour, first, first.AddDate(defaultHorizonYears, 0, 0))
+		if err != nil || len(starts) != 1 {
+			t.Errorf("occurrences() of %s = %v, %v, want only the first occurrence", value, starts, err)
+		}
+	}
+
+	// A period that does not move forward ends the expansion
+	rule := recurrenceRule{frequency: frequencyYearly, interval: 0, weekStartAt: time.Monday}
+
+	starts, err := rule.occurrences(first, time.Hour, first, first.AddDate(defaultHorizonYears, 0, 0))
+	if err != nil || len(starts) != 1 {
+		t.Errorf("occurrences() without interval = %v, %v, want only the first occurrence", starts, err)
+	}
+}
@@ -0,0 +1,230 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// occurrenceKey identify an interval, the occurrences of a recurring event share the ID but not the start
+type occurrenceKey struct {
+	id    int
+	start int64
+}
+
+// keyOf key of the interval given
+func keyOf(i interval) occurrenceKey {
+	return occurrenceKey{id: i.event.ID, start: i.start.Unix()}
+}
+
+// eventPriority priority of the event given, by default 1
+func eventPriority(event models.Event) int {
+	if event.Priority == 0 {
+		return 1
+	}
+
+	return event.Priority
+}
+
+// conflictComponents group the double booked intervals in the connected components of the conflict graph, every
+// occurrence of a recurring event is a different node
+func conflictComponents(conflicts []conflict) [][]interval {
+	indexes := map[occurrenceKey]int{}
+
+	var members []interval
+
+	indexOf := func(i interval) int {
+		key := keyOf(i)
+		if index, ok := indexes[key]; ok {
+			return index
+		}
+
+		indexes[key] = len(members)
+		members = append(members, i)
+
+		return indexes[key]
+	}
+
+	sets := unionFind{}
+	for _, c := range conflicts {
+		sets.union(indexOf(c.first), indexOf(c.second))
+	}
+
+	byRoot := map[int][]interval{}
+	roots := []int{}
+
+	for index, member := range members {
+		root := sets.find(index)
+		if _, ok := byRoot[root]; !ok {
+			roots = append(roots, root)
+		}
+
+		byRoot[root] = append(byRoot[root], member)
+	}
+
+	components := make([][]interval, 0, len(roots))
+	for _, root := range roots {
+		components = append(components, byRoot[root])
+	}
+
+	return components
+}
+
+// keepHighestPriority solve the weighted interval scheduling of the intervals given, it returns the intervals
+// kept so none of them overlap while their total priority is the highest possible.
+// The intervals are sorted by end and every one only needs the last interval that ended before it starts,
+// found with a binary search, so it runs in O(n log n)
+func keepHighestPriority(members []interval, mode models.OverlapMode) map[occurrenceKey]bool {
+	sorted := append([]interval{}, members...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].end.Equal(sorted[j].end) {
+			return sorted[i].end.Before(sorted[j].end)
+		}
+
+		if !sorted[i].start.Equal(sorted[j].start) {
+			return sorted[i].start.Before(sorted[j].start)
+		}
+
+		return sorted[i].event.ID < sorted[j].event.ID
+	})
+
+	// compatible[j] number of intervals that ended before the interval j starts, all of them come first
+	compatible := make([]int, len(sorted))
+	for j := range sorted {
+		compatible[j] = sort.Search(j, func(i int) bool {
+			return !hasEnded(sorted[i], sorted[j].start, mode)
+		})
+	}
+
+	// best[j] highest total priority keeping only the first j intervals
+	best := make([]int, len(sorted)+1)
+	for j, member := range sorted {
+		best[j+1] = best[j]
+
+		if kept := eventPriority(member.event) + best[compatible[j]]; kept > best[j] {
+			best[j+1] = kept
+		}
+	}
+
+	kept := map[occurrenceKey]bool{}
+
+	for j := len(sorted); j > 0; {
+		member := sorted[j-1]
+
+		if eventPriority(member.event)+best[compatible[j-1]] > best[j-1] {
+			kept[keyOf(member)] = true
+			j = compatible[j-1]
+		} else {
+			j--
+		}
+	}
+
+	return kept
+}
+
+// buildResolutionPlan find the double booked intervals to decline so the rest keep the highest total priority.
+// Every connected component of the conflicts is solved on its own as a weighted interval scheduling, where
+// the intervals running at the same time can not be kept together. The plan is always free of conflicts and
+// it is the best possible when every pair of the component running at the same time is double booked, like
+// the events without attendees
+func buildResolutionPlan(conflicts []conflict, mode models.OverlapMode) models.ResolutionPlan {
+	plan := models.ResolutionPlan{Steps: []models.ResolutionStep{}}
+
+	kept := map[occurrenceKey]bool{}
+
+	var declined []interval
+
+	for _, members := range conflictComponents(conflicts) {
+		keptInComponent := keepHighestPriority(members, mode)
+
+		for _, member := range members {
+			if keptInComponent[keyOf(member)] {
+				kept[keyOf(member)] = true
+				plan.KeptPriority += eventPriority(member.event)
+
+				continue
+			}
+
+			declined = append(declined, member)
+			plan.DeclinedPriority += eventPriority(member.event)
+		}
+	}
+
+	sortIntervals(declined)
+
+	for _, member := range declined {
+		plan.Steps = append(plan.Steps, newResolutionStep(member, keptConflicts(member, conflicts, kept)))
+	}
+
+	return plan
+}
+
+// keptConflicts kept intervals double booked with the interval given, sorted by start and ID without duplicates
+func keptConflicts(member interval, conflicts []conflict, kept map[occurrenceKey]bool) []interval {
+	key := keyOf(member)
+	seen := map[occurrenceKey]bool{}
+
+	var others []interval
+
+	for _, c := range conflicts {
+		other := c.second
+
+		switch key {
+		case keyOf(c.first):
+		case keyOf(c.second):
+			other = c.first
+		default:
+			continue
+		}
+
+		if kept[keyOf(other)] && !seen[keyOf(other)] {
+			seen[keyOf(other)] = true
+			others = append(others, other)
+		}
+	}
+
+	sortIntervals(others)
+
+	return others
+}
+
+// newResolutionStep build the step to decline the interval given explaining the kept events it is double booked with
+func newResolutionStep(member interval, others []interval) models.ResolutionStep {
+	step := models.ResolutionStep{
+		Action:        models.ResolutionActionDecline,
+		Event:         member.event.ID,
+		Occurrence:    member.event.OccurrenceStart,
+		Priority:      eventPriority(member.event),
+		ConflictsWith: []int{},
+	}
+
+	subject := fmt.Sprintf("event %d", member.event.ID)
+	if step.Occurrence != "" {
+		subject = fmt.Sprintf("the occurrence %s of event %d", step.Occurrence, member.event.ID)
+	}
+
+	if len(others) == 0 {
+		step.Explanation = fmt.Sprintf(
+			"Decline or move %s (priority %d), it runs at the same time as events kept with a higher total priority",
+			subject, step.Priority)
+
+		return step
+	}
+
+	ids := make([]string, 0, len(others))
+	total := 0
+
+	for _, other := range others {
+		step.ConflictsWith = append(step.ConflictsWith, other.event.ID)
+		ids = append(ids, fmt.Sprint(other.event.ID))
+		total += eventPriority(other.event)
+	}
+
+	step.Explanation = fmt.Sprintf(
+		"Decline or move %s (priority %d), it is double booked with the kept events %s (total priority %d)",
+		subject, step.Priority, strings.Join(ids, ", "), total)
+
+	return step
+}
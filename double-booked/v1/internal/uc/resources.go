@@ -0,0 +1,251 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// resourceConflict window where the bookings of a resource exceed its capacity
+type resourceConflict struct {
+	resourceID  string
+	capacity    int
+	peak        int
+	start       time.Time
+	end         time.Time
+	bookings    []int
+	occurrences []string
+}
+
+// resourceBoundary instant where a booking of a resource starts or ends, the booking is its index in the
+// bookings swept so every occurrence of a recurring booking is counted apart
+type resourceBoundary struct {
+	at      time.Time
+	delta   int
+	booking int
+}
+
+// resolveCapacities validate the resources given in the options and return the capacity of each one, every
+// resource given must host at least one booking
+func resolveCapacities(options models.Options) (map[string]int, error) {
+	capacities := map[string]int{}
+
+	for _, resource := range options.Resources {
+		if resource.Capacity < 1 {
+			return nil, invalidOptionError("resources.capacity", strconv.Itoa(resource.Capacity))
+		}
+
+		capacities[resource.ID] = resource.Capacity
+	}
+
+	return capacities, nil
+}
+
+// sameResource check if both intervals book the same resource, their overlap is checked against the resource
+// capacity
+func sameResource(a, b interval) bool {
+	return a.event.ResourceID != "" && a.event.ResourceID == b.event.ResourceID
+}
+
+// doubleBookedAttendees return the attendees of both intervals and if they are a double booked pair, the
+// bookings of the same resource are only a pair when someone attends both of them
+func doubleBookedAttendees(a, b interval) ([]string, bool) {
+	attendees, ok := sharedAttendees(a, b)
+	if sameResource(a, b) {
+		return attendees, len(attendees) > 0
+	}
+
+	return attendees, ok
+}
+
+// findResourceConflicts find the windows where the bookings of each resource exceed its capacity, resources
+// without a capacity can host one booking at a time. Bookings are [start, end), so back-to-back bookings never
+// share a seat, and the conflicts are sorted by resource and then by start
+func findResourceConflicts(intervals []interval, capacities map[string]int) []resourceConflict {
+	bookingsByResource := map[string][]interval{}
+
+	for _, current := range intervals {
+		if current.event.ResourceID == "" {
+			continue
+		}
+
+		bookingsByResource[current.event.ResourceID] = append(bookingsByResource[current.event.ResourceID], current)
+	}
+
+	resourceIDs := make([]string, 0, len(bookingsByResource))
+	for resourceID := range bookingsByResource {
+		resourceIDs = append(resourceIDs, resourceID)
+	}
+
+	sort.Strings(resourceIDs)
+
+	var conflicts []resourceConflict
+
+	for _, resourceID := range resourceIDs {
+		capacity := resourceCapacity(capacities, resourceID)
+		conflicts = append(conflicts, overCapacityWindows(resourceID, capacity, bookingsByResource[resourceID])...)
+	}
+
+	return conflicts
+}
+
+// resourceCapacity capacity of the resource given, the resources missing in the options host one booking at a time
+func resourceCapacity(capacities map[string]int, resourceID string) int {
+	if capacity, ok := capacities[resourceID]; ok {
+		return capacity
+	}
+
+	return 1
+}
+
+// overCapacityWindows sweep the bookings of a resource and merge the consecutive windows over its capacity. The
+// number of bookings running changes by one at every boundary and the running bookings are only walked when a
+// window over capacity opens, so it runs in O(n log n) plus the bookings involved
+func overCapacityWindows(resourceID string, capacity int, bookings []interval) []resourceConflict {
+	boundaries := make([]resourceBoundary, 0, len(bookings)*2)
+
+	for index, booking := range bookings {
+		if !booking.end.After(booking.start) {
+			continue
+		}
+
+		boundaries = append(boundaries,
+			resourceBoundary{at: booking.start, delta: 1, booking: index},
+			resourceBoundary{at: booking.end, delta: -1, booking: index},
+		)
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool {
+		return boundaries[i].at.Before(boundaries[j].at)
+	})
+
+	var (
+		conflicts []resourceConflict
+		current   *resourceConflict
+		involved  map[int]bool
+		count     int
+	)
+
+	active := map[int]int{}
+
+	for i := 0; i < len(boundaries); {
+		at := boundaries[i].at
+
+		var started []int
+
+		for ; i < len(boundaries) && boundaries[i].at.Equal(at); i++ {
+			boundary := boundaries[i]
+			count += boundary.delta
+
+			active[boundary.booking] += boundary.delta
+			if active[boundary.booking] == 0 {
+				delete(active, boundary.booking)
+			}
+
+			if boundary.delta > 0 {
+				started = append(started, boundary.booking)
+			}
+		}
+
+		if count <= capacity || i == len(boundaries) {
+			if current != nil {
+				current.bookings, current.occurrences = involvedBookings(bookings, involved)
+				conflicts = append(conflicts, *current)
+				current = nil
+			}
+
+			continue
+		}
+
+		// The window over capacity is open until the next boundary, and keeps growing while it stays over. The
+		// bookings running when it opens are involved and then only the ones starting inside it are added
+		if current == nil {
+			current = &resourceConflict{resourceID: resourceID, capacity: capacity, start: at}
+			involved = map[int]bool{}
+
+			for booking := range active {
+				involved[booking] = true
+			}
+		}
+
+		for _, booking := range started {
+			involved[booking] = true
+		}
+
+		current.end = boundaries[i].at
+
+		if count > current.peak {
+			current.peak = count
+		}
+	}
+
+	return conflicts
+}
+
+// involvedBookings return the IDs of the bookings given by their index sorted by ID and then by start, together
+// with the original starts of their occurrences in the same order when any of them recurs
+func involvedBookings(bookings []interval, involved map[int]bool) ([]int, []string) {
+	indexes := make([]int, 0, len(involved))
+	for index := range involved {
+		indexes = append(indexes, index)
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		a, b := bookings[indexes[i]], bookings[indexes[j]]
+		if a.event.ID != b.event.ID {
+			return a.event.ID < b.event.ID
+		}
+
+		return a.start.Before(b.start)
+	})
+
+	ids := make([]int, 0, len(indexes))
+	starts := make([]string, 0, len(indexes))
+	recurring := false
+
+	for _, index := range indexes {
+		ids = append(ids, bookings[index].event.ID)
+		starts = append(starts, bookings[index].event.OccurrenceStart)
+		recurring = recurring || bookings[index].event.OccurrenceStart != ""
+	}
+
+	if !recurring {
+		return ids, nil
+	}
+
+	return ids, starts
+}
+
+// sortedBookings return the IDs of the bookings given sorted
+func sortedBookings(bookings map[int]bool) []int {
+	sorted := make([]int, 0, len(bookings))
+	for booking := range bookings {
+		sorted = append(sorted, booking)
+	}
+
+	sort.Ints(sorted)
+
+	return sorted
+}
+
+// toResourceConflicts convert the resource conflicts in the list returned in the response, the local window is
+// shown in the location given
+func toResourceConflicts(conflicts []resourceConflict, location *time.Location) models.ResourceConflicts {
+	details := make(models.ResourceConflicts, 0, len(conflicts))
+
+	for _, c := range conflicts {
+		details = append(details, models.ResourceConflict{
+			ResourceID:  c.resourceID,
+			Capacity:    c.capacity,
+			Peak:        c.peak,
+			WindowUTC:   newTimeWindow(c.start, c.end, time.UTC),
+			WindowLocal: newTimeWindow(c.start, c.end, location),
+			Bookings:    c.bookings,
+			Occurrences: c.occurrences,
+		})
+	}
+
+	return details
+}
@@ -0,0 +1,80 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import "LiteraTest/double-booked/v1/internal/models"
+
+const (
+	// defaultWarningPercent percentage of the shorter event an overlap must cover to be a warning by default
+	defaultWarningPercent = 25
+	// defaultCriticalPercent percentage of the shorter event an overlap must cover to be critical by default
+	defaultCriticalPercent = 75
+)
+
+// severityRanks order of the severities, from the lowest to the highest
+var severityRanks = map[models.Severity]int{
+	models.SeverityInfo:     0,
+	models.SeverityWarning:  1,
+	models.SeverityCritical: 2,
+}
+
+// coveredPercent percentage of the shorter event covered by the overlap, a zero length event inside the other
+// one is fully covered
+func (c conflict) coveredPercent() float64 {
+	shorter := c.first.end.Sub(c.first.start)
+	if second := c.second.end.Sub(c.second.start); second < shorter {
+		shorter = second
+	}
+
+	if shorter == 0 {
+		return 100
+	}
+
+	return float64(c.overlapDuration()) * 100 / float64(shorter)
+}
+
+// tolerated check if the overlap of the conflict is small enough to be ignored
+func (t tolerance) tolerated(c conflict) bool {
+	if t.duration > 0 && c.overlapDuration() <= t.duration {
+		return true
+	}
+
+	return t.percent > 0 && c.coveredPercent() <= t.percent
+}
+
+// severityOf grade the conflict according to the thresholds given
+func severityOf(c conflict, thresholds models.SeverityThresholds) models.Severity {
+	switch covered := c.coveredPercent(); {
+	case covered >= thresholds.CriticalPercent:
+		return models.SeverityCritical
+	case covered >= thresholds.WarningPercent:
+		return models.SeverityWarning
+	default:
+		return models.SeverityInfo
+	}
+}
+
+// gradeConflicts set the severity of the conflicts and keep the ones that are not tolerated and are at least as
+// serious as the minimum severity given
+func gradeConflicts(
+	conflicts []conflict,
+	allowed tolerance,
+	thresholds models.SeverityThresholds,
+	minSeverity models.Severity,
+) []conflict {
+	graded := conflicts[:0]
+
+	for _, c := range conflicts {
+		if allowed.tolerated(c) {
+			continue
+		}
+
+		c.severity = severityOf(c, thresholds)
+		if severityRanks[c.severity] < severityRanks[minSeverity] {
+			continue
+		}
+
+		graded = append(graded, c)
+	}
+
+	return graded
+}
@@ -0,0 +1,70 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"time"
+)
+
+// offsetLayouts ISO 8601 layouts of the date times given with their UTC offset, RFC 3339 included. A fraction
+// of a second is accepted after the seconds of every layout
+var offsetLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04Z0700",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04Z07:00",
+	"20060102T150405Z0700",
+}
+
+// wallClockLayouts layouts of the date times given without offset, they are in the timezone of their event
+var wallClockLayouts = []string{
+	LayoutFormat,
+	SecondsLayoutFormat,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"20060102T150405",
+}
+
+// parseOffsetTime parse a date time given with its UTC offset, it returns false when the value has no offset.
+// The precision is kept down to seconds
+func parseOffsetTime(value string) (time.Time, bool) {
+	for _, layout := range offsetLayouts {
+		if dateTime, err := time.Parse(layout, value); err == nil {
+			return dateTime.Truncate(time.Second), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseWallClockTime parse the wall clock time of a date time given without offset, its fields are set in UTC
+// until the location of its event resolves it, the precision is kept down to seconds
+func parseWallClockTime(value string) (time.Time, bool) {
+	for _, layout := range wallClockLayouts {
+		if dateTime, err := time.Parse(layout, value); err == nil {
+			return dateTime.Truncate(time.Second), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseUTCTime parse a date time already normalized to UTC, given with or without seconds
+func parseUTCTime(value string) (time.Time, error) {
+	if dateTime, err := time.Parse(LayoutFormat, value); err == nil {
+		return dateTime, nil
+	}
+
+	return time.Parse(SecondsLayoutFormat, value)
+}
+
+// formatTime format the date time given with minutes, or with seconds when it is not at the start of a minute,
+// so the instants given with seconds keep them
+func formatTime(dateTime time.Time) string {
+	if dateTime.Second() != 0 {
+		return dateTime.Format(SecondsLayoutFormat)
+	}
+
+	return dateTime.Format(LayoutFormat)
+}
@@ -0,0 +1,114 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"strings"
+	"time"
+)
+
+// hoursLayoutFormat format of the working hours of a participant
+const hoursLayoutFormat = "15:04"
+
+// workingHours working hours of a participant already parsed, the end is minutes from the start of the day
+type workingHours struct {
+	startMinute int
+	endMinute   int
+	days        map[time.Weekday]bool
+}
+
+// parseWorkingHours validate the working hours given, an empty start is the start of the day and an empty end
+// is the end of the day, the end must be after the start
+func parseWorkingHours(hours models.WorkingHours) (workingHours, error) {
+	parsed := workingHours{startMinute: 0, endMinute: 24 * 60}
+
+	if hours.Start != "" {
+		start, err := time.Parse(hoursLayoutFormat, hours.Start)
+		if err != nil {
+			return workingHours{}, invalidOptionError("working_hours.start", hours.Start)
+		}
+
+		parsed.startMinute = start.Hour()*60 + start.Minute()
+	}
+
+	if hours.End != "" {
+		end, err := time.Parse(hoursLayoutFormat, hours.End)
+		if err != nil {
+			return workingHours{}, invalidOptionError("working_hours.end", hours.End)
+		}
+
+		parsed.endMinute = end.Hour()*60 + end.Minute()
+	}
+
+	if parsed.endMinute <= parsed.startMinute {
+		return workingHours{}, invalidOptionError("working_hours.end", hours.End)
+	}
+
+	for _, day := range hours.Days {
+		weekday, ok := parseWeekday(day)
+		if !ok {
+			return workingHours{}, invalidOptionError("working_hours.days", day)
+		}
+
+		if parsed.days == nil {
+			parsed.days = map[time.Weekday]bool{}
+		}
+
+		parsed.days[weekday] = true
+	}
+
+	return parsed, nil
+}
+
+// parseWeekday parse the english name of a week day, ignoring its case
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), day) {
+			return weekday, true
+		}
+	}
+
+	return time.Sunday, false
+}
+
+// ranges build the UTC ranges inside [from, to) covered by the working hours in the location given, every day
+// is built from its own local date so the ranges follow the DST changes of the location. The days that touch,
+// like whole days without working hours, are merged so the free time is not cut at midnight
+func (w workingHours) ranges(location *time.Location, from, to time.Time) []timeRange {
+	var ranges []timeRange
+
+	localFrom := from.In(location)
+	year, month, day := localFrom.Date()
+
+	// The previous day is included because its working hours may still be running in UTC
+	for date := time.Date(year, month, day-1, 0, 0, 0, 0, location); date.Before(to); date = date.AddDate(0, 0, 1) {
+		if w.days != nil && !w.days[date.Weekday()] {
+			continue
+		}
+
+		start := time.Date(date.Year(), date.Month(), date.Day(), 0, w.startMinute, 0, 0, location)
+		end := time.Date(date.Year(), date.Month(), date.Day(), 0, w.endMinute, 0, 0, location)
+
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		if !end.After(start) {
+			continue
+		}
+
+		if len(ranges) > 0 && !start.After(ranges[len(ranges)-1].end) {
+			ranges[len(ranges)-1].end = end.UTC()
+
+			continue
+		}
+
+		ranges = append(ranges, timeRange{start: start.UTC(), end: end.UTC()})
+	}
+
+	return ranges
+}
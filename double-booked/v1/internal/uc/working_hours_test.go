@@ -0,0 +1,70 @@
+// Package uc have all the logic related to use cases
+package uc
+
+import (
+	"LiteraTest/double-booked/v1/internal/models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Test_workingHours_ranges test for this method
+func Test_workingHours_ranges(t *testing.T) {
+	t.Parallel()
+
+	location, _ := time.LoadLocation("America/New_York")
+	from := time.Date(2023, 3, 11, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		hours models.WorkingHours
+		want  []timeRange
+	}{
+		{
+			name:  "Working hours follow the DST change",
+			hours: models.WorkingHours{Start: "09:00", End: "17:00"},
+			want: []timeRange{
+				{
+					start: time.Date(2023, 3, 11, 14, 0, 0, 0, time.UTC),
+					end:   time.Date(2023, 3, 11, 22, 0, 0, 0, time.UTC),
+				},
+				{
+					start: time.Date(2023, 3, 12, 13, 0, 0, 0, time.UTC),
+					end:   time.Date(2023, 3, 12, 21, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:  "Whole days merged across midnight",
+			hours: models.WorkingHours{},
+			want:  []timeRange{{start: from, end: to}},
+		},
+		{
+			name:  "Only the working days given",
+			hours: models.WorkingHours{Start: "09:00", End: "17:00", Days: []string{"sunday"}},
+			want: []timeRange{
+				{
+					start: time.Date(2023, 3, 12, 13, 0, 0, 0, time.UTC),
+					end:   time.Date(2023, 3, 12, 21, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hours, err := parseWorkingHours(tt.hours)
+			if err != nil {
+				t.Fatalf("parseWorkingHours() error = %v", err)
+			}
+
+			if got := hours.ranges(location, from, to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ranges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}